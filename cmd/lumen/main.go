@@ -20,7 +20,7 @@ func main() {
 	lister := list.NewCatalogLister(logger, cataloger, imager)
 	printer := printer.NewPrinter(os.Stdout, logger)
 
-	if err := cli.NewLumenCmd(lister, printer).Execute(); err != nil {
+	if err := cli.NewLumenCmd(lister, printer, cataloger).Execute(); err != nil {
 		logger.Fatal(err)
 	}
 }
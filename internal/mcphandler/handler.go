@@ -13,7 +13,8 @@ import (
 
 // LumenToolHandler is the function that would be registered with an MCP server or agent tooling platform.
 // It acts as a handler between the agent's tool call and our Go library.
-func LumenToolHandler(catalogRef, ocpVersion, packageName, channelName string, listCatalogs bool) (string, error) {
+// The bundle filter only applies when listing the bundles of a channel.
+func LumenToolHandler(catalogRef, ocpVersion, packageName, channelName string, listCatalogs bool, bundleFilter list.BundleFilter) (string, error) {
 	var (
 		result any
 		err    error
@@ -27,10 +28,14 @@ func LumenToolHandler(catalogRef, ocpVersion, packageName, channelName string, l
 
 	switch {
 	case listCatalogs:
-		result, err = lister.Catalogs(ocpVersion)
+		result, err = lister.Catalogs(ocpVersion, list.DefaultCatalogSources())
 	case packageName != "":
 		if channelName != "" {
-			result, err = lister.BundleVersionsByChannel(catalogRef, packageName, channelName)
+			var bundles []list.ChannelEntry
+			bundles, err = lister.BundleVersionsByChannel(catalogRef, packageName, channelName)
+			if err == nil {
+				result, err = list.FilterBundles(bundles, bundleFilter)
+			}
 		} else {
 			result, err = lister.ChannelsByPackage(catalogRef, packageName)
 		}
@@ -0,0 +1,125 @@
+package catalog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+const (
+	// ConfigsLabel is the image label pointing opm to the FBC inside a catalog image.
+	ConfigsLabel = "operators.operatorframework.io.index.configs.v1"
+	// configsDir is the directory the FBC is added to in catalog images.
+	configsDir = "/configs"
+)
+
+// BuildOptions describes a catalog image to build.
+type BuildOptions struct {
+	// FromDir is the file-based catalog directory added to the image.
+	FromDir string
+	// Base is the opm base image, as a registry reference, a local OCI layout directory or a
+	// transport qualified reference such as oci:./base:tag.
+	Base string
+	// Output is the oci: or oci-archive: reference the image is written to.
+	Output string
+	// Push is the registry reference the image is also pushed to, if any.
+	Push string
+	// Arch selects the architecture of multi-architecture base images, the host one by default.
+	Arch string
+}
+
+// BuiltImage describes a catalog image written by BuildImage.
+type BuiltImage struct {
+	Base     string `json:"base"`
+	Output   string `json:"output"`
+	Push     string `json:"push,omitempty"`
+	Digest   string `json:"digest"`
+	Packages int    `json:"packages"`
+}
+
+// BuildImage builds a catalog image without a container engine. The FBC of FromDir is validated,
+// added to the base image as a /configs layer along with the configs label and the opm serve
+// command, and the result is written to Output and optionally pushed to Push.
+func (c *Cataloger) BuildImage(opts BuildOptions) (*BuiltImage, error) {
+	if opts.FromDir == "" || opts.Base == "" || opts.Output == "" {
+		return nil, fmt.Errorf("an FBC directory, a base image and an output reference are required")
+	}
+	if !strings.HasPrefix(opts.Output, "oci:") && !strings.HasPrefix(opts.Output, "oci-archive:") {
+		return nil, fmt.Errorf("invalid output %q, expected an oci: or oci-archive: reference", opts.Output)
+	}
+
+	c.log.Debugf("Validating FBC in %s...", opts.FromDir)
+	cfg, err := declcfg.LoadFS(context.Background(), os.DirFS(opts.FromDir))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load FBC from %s: %w", opts.FromDir, err)
+	}
+	if _, err := declcfg.ConvertToModel(*cfg); err != nil {
+		return nil, fmt.Errorf("invalid FBC in %s: %w", opts.FromDir, err)
+	}
+
+	tmpDir, err := os.MkdirTemp("", "lumen-build-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp build dir: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	c.log.Infof("Copying base image %s...", opts.Base)
+	layoutDir := filepath.Join(tmpDir, "layout")
+	layoutRef := "oci:" + layoutDir
+	if _, err := c.imager.CopyImage(baseImageRef(opts.Base), layoutRef, opts.Arch); err != nil {
+		return nil, fmt.Errorf("failed to copy base image: %w", err)
+	}
+
+	// The layer holds the FBC under configs/, so it lands in /configs once extracted.
+	layerDir := filepath.Join(tmpDir, "layer")
+	if err := c.fsio.CopyDirectory(opts.FromDir, filepath.Join(layerDir, strings.TrimPrefix(configsDir, "/"))); err != nil {
+		return nil, fmt.Errorf("failed to stage FBC: %w", err)
+	}
+	var layer bytes.Buffer
+	if err := c.fsio.TarDirectory(layerDir, &layer); err != nil {
+		return nil, fmt.Errorf("failed to create FBC layer: %w", err)
+	}
+	if _, err := c.imager.AppendLayer(layoutDir, &layer, ociv1.ImageConfig{
+		Labels:     map[string]string{ConfigsLabel: configsDir},
+		Entrypoint: []string{"/bin/opm"},
+		Cmd:        []string{"serve", configsDir, "--cache-dir=/tmp/cache"},
+	}); err != nil {
+		return nil, fmt.Errorf("failed to add FBC layer: %w", err)
+	}
+
+	c.log.Infof("Writing catalog image to %s...", opts.Output)
+	d, err := c.imager.CopyImage(layoutRef, opts.Output, "")
+	if err != nil {
+		return nil, err
+	}
+	if opts.Push != "" {
+		c.log.Infof("Pushing catalog image to %s...", opts.Push)
+		if _, err := c.imager.CopyImage(layoutRef, "docker://"+strings.TrimPrefix(opts.Push, "docker://"), ""); err != nil {
+			return nil, err
+		}
+	}
+
+	c.log.Debugf("Built catalog image %s with digest %s", opts.Output, d)
+	return &BuiltImage{Base: opts.Base, Output: opts.Output, Push: opts.Push, Digest: d, Packages: len(cfg.Packages)}, nil
+}
+
+// baseImageRef returns the transport qualified reference of a base image. References with a
+// transport are kept, existing directories are read as OCI layouts and anything else is pulled
+// from a registry.
+func baseImageRef(base string) string {
+	for _, transport := range []string{"docker://", "oci:", "oci-archive:"} {
+		if strings.HasPrefix(base, transport) {
+			return base
+		}
+	}
+	if info, err := os.Stat(base); err == nil && info.IsDir() {
+		return "oci:" + base
+	}
+	return "docker://" + base
+}
@@ -0,0 +1,186 @@
+package catalog_test
+
+import (
+	"archive/tar"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+	catalogMock "github.com/aguidirh/lumen/internal/pkg/catalog/mock"
+	"github.com/aguidirh/lumen/internal/pkg/fsio"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// writeFBC writes a valid single package FBC to dir.
+func writeFBC(t *testing.T, dir string) {
+	t.Helper()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "logging"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "logging", "catalog.yaml"), []byte(`
+schema: olm.package
+name: logging
+defaultChannel: stable
+---
+schema: olm.channel
+name: stable
+package: logging
+entries:
+- name: logging.v1.0.0
+---
+schema: olm.bundle
+name: logging.v1.0.0
+package: logging
+image: registry.example.com/logging-bundle:v1.0.0
+properties:
+- type: olm.package
+  value:
+    packageName: logging
+    version: 1.0.0
+`), 0644))
+}
+
+func TestCataloger_BuildImage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	imager := catalogMock.NewMockImager(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	fbcDir := t.TempDir()
+	writeFBC(t, fbcDir)
+
+	var layoutRef string
+	gomock.InOrder(
+		imager.EXPECT().CopyImage("docker://quay.io/operator-framework/opm:latest", gomock.Any(), "arm64").
+			DoAndReturn(func(_, destRef, _ string) (string, error) {
+				layoutRef = destRef
+				return "sha256:base", nil
+			}),
+		imager.EXPECT().AppendLayer(gomock.Any(), gomock.Any(), ociv1.ImageConfig{
+			Labels:     map[string]string{catalog.ConfigsLabel: "/configs"},
+			Entrypoint: []string{"/bin/opm"},
+			Cmd:        []string{"serve", "/configs", "--cache-dir=/tmp/cache"},
+		}).DoAndReturn(func(ociDir string, layer io.Reader, _ ociv1.ImageConfig) (string, error) {
+			assert.Equal(t, layoutRef, "oci:"+ociDir)
+			var names []string
+			tr := tar.NewReader(layer)
+			for {
+				header, err := tr.Next()
+				if err == io.EOF {
+					break
+				}
+				require.NoError(t, err)
+				names = append(names, header.Name)
+			}
+			assert.Equal(t, []string{"configs/", "configs/logging/", "configs/logging/catalog.yaml"}, names)
+			return "sha256:built", nil
+		}),
+		imager.EXPECT().CopyImage(gomock.Any(), "oci:./out:v1", "").DoAndReturn(func(srcRef, _, _ string) (string, error) {
+			assert.Equal(t, layoutRef, srcRef)
+			return "sha256:built", nil
+		}),
+		imager.EXPECT().CopyImage(gomock.Any(), "docker://registry.example.com/catalog:v1", "").Return("sha256:built", nil),
+	)
+
+	cataloger := catalog.NewCataloger(logger, imager, fsio.NewFsIO())
+	built, err := cataloger.BuildImage(catalog.BuildOptions{
+		FromDir: fbcDir,
+		Base:    "quay.io/operator-framework/opm:latest",
+		Output:  "oci:./out:v1",
+		Push:    "registry.example.com/catalog:v1",
+		Arch:    "arm64",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &catalog.BuiltImage{
+		Base:     "quay.io/operator-framework/opm:latest",
+		Output:   "oci:./out:v1",
+		Push:     "registry.example.com/catalog:v1",
+		Digest:   "sha256:built",
+		Packages: 1,
+	}, built)
+}
+
+func TestCataloger_BuildImage_LocalBase(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	imager := catalogMock.NewMockImager(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	fbcDir := t.TempDir()
+	writeFBC(t, fbcDir)
+	baseDir := t.TempDir()
+
+	imager.EXPECT().CopyImage("oci:"+baseDir, gomock.Any(), "").Return("sha256:base", nil)
+	imager.EXPECT().AppendLayer(gomock.Any(), gomock.Any(), gomock.Any()).Return("sha256:built", nil)
+	imager.EXPECT().CopyImage(gomock.Any(), "oci-archive:./catalog.tar", "").Return("sha256:built", nil)
+
+	cataloger := catalog.NewCataloger(logger, imager, fsio.NewFsIO())
+	built, err := cataloger.BuildImage(catalog.BuildOptions{FromDir: fbcDir, Base: baseDir, Output: "oci-archive:./catalog.tar"})
+	require.NoError(t, err)
+	assert.Equal(t, "sha256:built", built.Digest)
+}
+
+func TestCataloger_BuildImage_Invalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	cataloger := catalog.NewCataloger(logger, catalogMock.NewMockImager(ctrl), catalogMock.NewMockFsIO(ctrl))
+
+	// A channel entry without a matching bundle.
+	invalidDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(invalidDir, "catalog.yaml"), []byte(`
+schema: olm.package
+name: logging
+defaultChannel: stable
+---
+schema: olm.channel
+name: stable
+package: logging
+entries:
+- name: logging.v1.0.0
+`), 0644))
+
+	testCases := []struct {
+		name          string
+		opts          catalog.BuildOptions
+		expectedError string
+	}{
+		{
+			name:          "Missing Base",
+			opts:          catalog.BuildOptions{FromDir: invalidDir, Output: "oci:./out"},
+			expectedError: "an FBC directory, a base image and an output reference are required",
+		},
+		{
+			name:          "Registry Output",
+			opts:          catalog.BuildOptions{FromDir: invalidDir, Base: "opm:latest", Output: "docker://registry.example.com/catalog:v1"},
+			expectedError: `invalid output "docker://registry.example.com/catalog:v1", expected an oci: or oci-archive: reference`,
+		},
+		{
+			name:          "Invalid FBC",
+			opts:          catalog.BuildOptions{FromDir: invalidDir, Base: "opm:latest", Output: "oci:./out"},
+			expectedError: "invalid FBC in " + invalidDir,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := cataloger.BuildImage(tc.opts)
+			require.Error(t, err)
+			assert.True(t, strings.HasPrefix(err.Error(), tc.expectedError), err.Error())
+		})
+	}
+}
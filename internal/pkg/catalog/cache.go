@@ -0,0 +1,253 @@
+package catalog
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// workingDir is the directory, relative to the current directory, where lumen keeps its state.
+	workingDir = "working-dir"
+	// catalogsCacheDir is the directory inside workingDir holding the extracted catalogs.
+	catalogsCacheDir = "operator-catalogs"
+	// cacheEntryFile is the name of the file describing a cached catalog.
+	cacheEntryFile = "metadata.json"
+	// cacheManifestFile is the name of the file listing the catalogs of an exported cache bundle.
+	cacheManifestFile = "lumen-cache.json"
+	// cacheManifestVersion is the version of the cache bundle format written by ExportCache.
+	cacheManifestVersion = 1
+)
+
+// CacheEntry describes a catalog stored in the local cache.
+type CacheEntry struct {
+	Reference string        `json:"reference"`
+	Name      string        `json:"name"`
+	Tag       string        `json:"tag,omitempty"`
+	Digest    digest.Digest `json:"digest"`
+	CachedAt  time.Time     `json:"cachedAt"`
+}
+
+// cacheManifest is the table of contents of an exported cache bundle.
+type cacheManifest struct {
+	Version  int          `json:"version"`
+	Catalogs []CacheEntry `json:"catalogs"`
+}
+
+// relPath returns the location of the cached catalog relative to the working directory.
+func (e *CacheEntry) relPath() string {
+	// TODO not sure if this safeDigest is the correct one to use.
+	// from the tests, it seems that the digest is not the correct one to use.
+	safeDigest := strings.Replace(e.Digest.String(), ":", "-", 1)
+	return filepath.Join(catalogsCacheDir, e.Name, e.Tag, safeDigest)
+}
+
+// validate checks that a cache entry read from an untrusted source, such as a cache bundle, has a
+// valid digest and a location inside the catalogs cache, so that it cannot escape the cache.
+func (e *CacheEntry) validate() error {
+	if err := e.Digest.Validate(); err != nil {
+		return fmt.Errorf("invalid digest %q of cached catalog %s: %w", e.Digest, e.Reference, err)
+	}
+	if e.Name == "" || !strings.HasPrefix(e.relPath(), catalogsCacheDir+string(filepath.Separator)) ||
+		filepath.IsAbs(e.Name) || filepath.IsAbs(e.Tag) {
+		return fmt.Errorf("invalid name %q or tag %q of cached catalog %s, it must be located inside the cache", e.Name, e.Tag, e.Reference)
+	}
+	return nil
+}
+
+// configsPath returns the directory holding the extracted FBC of the cached catalog.
+func (e *CacheEntry) configsPath() string {
+	return filepath.Join(workingDir, e.relPath(), "configs")
+}
+
+// ExportCache writes the cached catalogs referenced by catalogRefs, together with
+// their tag to digest mapping, into a tar archive at archivePath. Catalogs that are
+// not cached yet are pulled first.
+func (c *Cataloger) ExportCache(catalogRefs []string, archivePath string) ([]CacheEntry, error) {
+	if len(catalogRefs) == 0 {
+		return nil, fmt.Errorf("at least one catalog reference is required")
+	}
+	if archivePath == "" {
+		return nil, fmt.Errorf("an output archive path is required")
+	}
+
+	stagingDir, err := os.MkdirTemp("", "lumen-cache-export-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp staging dir: %w", err)
+	}
+	defer os.RemoveAll(stagingDir)
+
+	manifest := cacheManifest{Version: cacheManifestVersion}
+	for _, catalogRef := range catalogRefs {
+		entry, err := c.cachedCatalog(catalogRef)
+		if err != nil {
+			return nil, err
+		}
+		c.log.Debugf("Adding cached catalog %s (%s) to the bundle...", catalogRef, entry.Digest)
+		src := filepath.Join(workingDir, entry.relPath())
+		if err := c.fsio.CopyDirectory(src, filepath.Join(stagingDir, entry.relPath())); err != nil {
+			return nil, fmt.Errorf("failed to stage cached catalog %s: %w", catalogRef, err)
+		}
+		manifest.Catalogs = append(manifest.Catalogs, *entry)
+	}
+
+	manifestBytes, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to serialize cache manifest: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(stagingDir, cacheManifestFile), manifestBytes, 0644); err != nil {
+		return nil, fmt.Errorf("failed to write cache manifest: %w", err)
+	}
+
+	out, err := os.Create(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create archive %s: %w", archivePath, err)
+	}
+	err = c.fsio.TarDirectory(stagingDir, out)
+	if closeErr := out.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Do not leave a partial archive behind.
+		os.Remove(archivePath)
+		return nil, fmt.Errorf("failed to write archive %s: %w", archivePath, err)
+	}
+
+	c.log.Debugf("Exported %d catalogs to %s", len(manifest.Catalogs), archivePath)
+	return manifest.Catalogs, nil
+}
+
+// ImportCache loads a cache bundle created by ExportCache into the local cache,
+// so its catalogs can be queried without access to a registry.
+func (c *Cataloger) ImportCache(archivePath string) ([]CacheEntry, error) {
+	if archivePath == "" {
+		return nil, fmt.Errorf("an archive path is required")
+	}
+
+	in, err := os.Open(archivePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open archive %s: %w", archivePath, err)
+	}
+	defer in.Close()
+
+	stagingDir, err := os.MkdirTemp("", "lumen-cache-import-")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp staging dir: %w", err)
+	}
+	defer os.RemoveAll(stagingDir)
+
+	if err := c.fsio.UntarFromStream(in, stagingDir); err != nil {
+		return nil, fmt.Errorf("failed to extract archive %s: %w", archivePath, err)
+	}
+
+	manifestBytes, err := os.ReadFile(filepath.Join(stagingDir, cacheManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("archive %s is not a lumen cache bundle: %w", archivePath, err)
+	}
+	var manifest cacheManifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse cache manifest: %w", err)
+	}
+	if manifest.Version != cacheManifestVersion {
+		return nil, fmt.Errorf("unsupported cache bundle version %d", manifest.Version)
+	}
+
+	for _, entry := range manifest.Catalogs {
+		if err := entry.validate(); err != nil {
+			return nil, fmt.Errorf("invalid cache bundle %s: %w", archivePath, err)
+		}
+	}
+	for _, entry := range manifest.Catalogs {
+		src := filepath.Join(stagingDir, entry.relPath())
+		if _, err := os.Stat(filepath.Join(src, "configs")); err != nil {
+			return nil, fmt.Errorf("cache bundle is missing the configs of %s: %w", entry.Reference, err)
+		}
+		c.log.Debugf("Importing cached catalog %s (%s)...", entry.Reference, entry.Digest)
+		if err := c.fsio.CopyDirectory(src, filepath.Join(workingDir, entry.relPath())); err != nil {
+			return nil, fmt.Errorf("failed to import cached catalog %s: %w", entry.Reference, err)
+		}
+	}
+
+	c.log.Debugf("Imported %d catalogs from %s", len(manifest.Catalogs), archivePath)
+	return manifest.Catalogs, nil
+}
+
+// readCacheEntry reads the cache entry stored in the given cache directory.
+func readCacheEntry(dir string) (*CacheEntry, error) {
+	data, err := os.ReadFile(filepath.Join(dir, cacheEntryFile))
+	if err != nil {
+		return nil, err
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil, fmt.Errorf("failed to parse cache entry %s: %w", dir, err)
+	}
+	return &entry, nil
+}
+
+// writeCacheEntry stores the cache entry next to the configs it describes.
+func writeCacheEntry(entry *CacheEntry) error {
+	data, err := json.MarshalIndent(entry, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to serialize cache entry: %w", err)
+	}
+	path := filepath.Join(workingDir, entry.relPath(), cacheEntryFile)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return fmt.Errorf("failed to write cache entry %s: %w", path, err)
+	}
+	return nil
+}
+
+// lookupCacheEntry finds the most recently cached catalog matching imageRef
+// without contacting the registry.
+func lookupCacheEntry(imageRef string) (*CacheEntry, error) {
+	named, err := reference.ParseNormalizedNamed(imageRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse image reference %s: %w", imageRef, err)
+	}
+	var tag string
+	if tagged, ok := named.(reference.NamedTagged); ok {
+		tag = tagged.Tag()
+	}
+	var dgst digest.Digest
+	if digested, ok := named.(reference.Digested); ok {
+		dgst = digested.Digest()
+	}
+
+	var found *CacheEntry
+	root := filepath.Join(workingDir, catalogsCacheDir, named.Name())
+	err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || d.Name() != cacheEntryFile {
+			return nil
+		}
+		entry, err := readCacheEntry(filepath.Dir(path))
+		if err != nil {
+			return nil
+		}
+		if entry.Name != named.Name() || (tag != "" && entry.Tag != tag) || (dgst != "" && entry.Digest != dgst) {
+			return nil
+		}
+		if found == nil || entry.CachedAt.After(found.CachedAt) {
+			found = entry
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if found == nil {
+		return nil, fmt.Errorf("catalog %s not found in the local cache", imageRef)
+	}
+	return found, nil
+}
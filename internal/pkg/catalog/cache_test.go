@@ -0,0 +1,218 @@
+package catalog_test
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+	catalogMock "github.com/aguidirh/lumen/internal/pkg/catalog/mock"
+	"github.com/aguidirh/lumen/internal/pkg/fsio"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// seedCache creates a cached catalog in the working-dir of dir and returns its configs path.
+func seedCache(t *testing.T, dir, name, tag string, d digest.Digest) string {
+	t.Helper()
+	safeDigest := strings.Replace(d.String(), ":", "-", 1)
+	configsCachePath := filepath.Join(dir, "working-dir", "operator-catalogs", name, tag, safeDigest, "configs")
+	require.NoError(t, os.MkdirAll(configsCachePath, 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(configsCachePath, "catalog.yaml"), []byte(`
+schema: olm.package
+name: test-package
+`), 0644))
+	return configsCachePath
+}
+
+// chdir changes the working directory for the duration of the test.
+func chdir(t *testing.T, dir string) {
+	t.Helper()
+	originalWd, err := os.Getwd()
+	require.NoError(t, err)
+	t.Cleanup(func() { os.Chdir(originalWd) })
+	require.NoError(t, os.Chdir(dir))
+}
+
+func TestCataloger_ExportImportCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	imager := catalogMock.NewMockImager(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any()).AnyTimes()
+	logger.EXPECT().Infof(gomock.Any(), gomock.Any()).AnyTimes()
+
+	imageRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	name := "registry.redhat.io/redhat/redhat-operator-index"
+	tag := "v4.15"
+	testDigest := digest.FromString("test-content")
+
+	// Export from a connected machine with a populated cache.
+	connectedDir := t.TempDir()
+	seedCache(t, connectedDir, name, tag, testDigest)
+	archivePath := filepath.Join(t.TempDir(), "catalogs.tar")
+	chdir(t, connectedDir)
+
+	imager.EXPECT().RemoteInfo(imageRef).Return(name, tag, testDigest, nil)
+
+	cataloger := catalog.NewCataloger(logger, imager, fsio.NewFsIO())
+	exported, err := cataloger.ExportCache([]string{imageRef}, archivePath)
+	require.NoError(t, err)
+	require.Len(t, exported, 1)
+	assert.Equal(t, imageRef, exported[0].Reference)
+	assert.Equal(t, testDigest, exported[0].Digest)
+	assert.FileExists(t, archivePath)
+
+	// Import on a disconnected machine with an empty cache.
+	disconnectedDir := t.TempDir()
+	chdir(t, disconnectedDir)
+
+	imported, err := cataloger.ImportCache(archivePath)
+	require.NoError(t, err)
+	assert.Equal(t, exported, imported)
+
+	// The registry is unreachable, the imported catalog must be served from the cache.
+	imager.EXPECT().RemoteInfo(imageRef).Return("", "", digest.Digest(""), fmt.Errorf("no route to host"))
+
+	config, err := cataloger.CatalogConfig(imageRef)
+	require.NoError(t, err)
+	require.Len(t, config.Packages, 1)
+	assert.Equal(t, "test-package", config.Packages[0].Name)
+}
+
+func TestCataloger_ExportCache_MissingArgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cataloger := catalog.NewCataloger(catalogMock.NewMockLogger(ctrl), catalogMock.NewMockImager(ctrl), catalogMock.NewMockFsIO(ctrl))
+
+	_, err := cataloger.ExportCache(nil, "catalogs.tar")
+	assert.EqualError(t, err, "at least one catalog reference is required")
+
+	_, err = cataloger.ExportCache([]string{"registry.redhat.io/redhat/redhat-operator-index:v4.15"}, "")
+	assert.EqualError(t, err, "an output archive path is required")
+}
+
+func TestCataloger_ImportCache_NotABundle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	imager := catalogMock.NewMockImager(ctrl)
+	fs := fsio.NewFsIO()
+
+	// A tar archive without a cache manifest.
+	srcDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(srcDir, "random.txt"), []byte("data"), 0644))
+	archivePath := filepath.Join(t.TempDir(), "random.tar")
+	out, err := os.Create(archivePath)
+	require.NoError(t, err)
+	require.NoError(t, fs.TarDirectory(srcDir, out))
+	require.NoError(t, out.Close())
+
+	chdir(t, t.TempDir())
+
+	cataloger := catalog.NewCataloger(logger, imager, fs)
+	entries, err := cataloger.ImportCache(archivePath)
+
+	assert.Nil(t, entries)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "is not a lumen cache bundle")
+}
+
+func TestCataloger_ExportCache_RemovesPartialArchive(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	imager := catalogMock.NewMockImager(ctrl)
+	fs := catalogMock.NewMockFsIO(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any()).AnyTimes()
+
+	imageRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	name := "registry.redhat.io/redhat/redhat-operator-index"
+	testDigest := digest.FromString("test-content")
+	dir := t.TempDir()
+	seedCache(t, dir, name, "v4.15", testDigest)
+	chdir(t, dir)
+	archivePath := filepath.Join(t.TempDir(), "catalogs.tar")
+
+	imager.EXPECT().RemoteInfo(imageRef).Return(name, "v4.15", testDigest, nil)
+	fs.EXPECT().CopyDirectory(gomock.Any(), gomock.Any()).Return(nil)
+	fs.EXPECT().TarDirectory(gomock.Any(), gomock.Any()).DoAndReturn(func(_ string, w io.Writer) error {
+		w.Write([]byte("partial"))
+		return fmt.Errorf("disk full")
+	})
+
+	cataloger := catalog.NewCataloger(logger, imager, fs)
+	_, err := cataloger.ExportCache([]string{imageRef}, archivePath)
+	assert.EqualError(t, err, fmt.Sprintf("failed to write archive %s: disk full", archivePath))
+	assert.NoFileExists(t, archivePath)
+}
+
+func TestCataloger_ImportCache_InvalidEntries(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := catalogMock.NewMockLogger(ctrl)
+	logger.EXPECT().Debugf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	fs := fsio.NewFsIO()
+	validDigest := digest.FromString("test-content")
+
+	testCases := []struct {
+		name          string
+		entry         catalog.CacheEntry
+		expectedError string
+	}{
+		{
+			name:          "Name Traversal",
+			entry:         catalog.CacheEntry{Reference: "evil", Name: "../../escape", Tag: "v1", Digest: validDigest},
+			expectedError: `invalid name "../../escape" or tag "v1" of cached catalog evil, it must be located inside the cache`,
+		},
+		{
+			name:          "Tag Traversal",
+			entry:         catalog.CacheEntry{Reference: "evil", Name: "registry.example.com/catalog", Tag: "../../../../escape", Digest: validDigest},
+			expectedError: `invalid name "registry.example.com/catalog" or tag "../../../../escape" of cached catalog evil, it must be located inside the cache`,
+		},
+		{
+			name:          "Invalid Digest",
+			entry:         catalog.CacheEntry{Reference: "evil", Name: "registry.example.com/catalog", Tag: "v1", Digest: "sha256:../../escape"},
+			expectedError: `invalid digest "sha256:../../escape" of cached catalog evil: invalid checksum digest length`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// A bundle whose manifest points outside the cache, with configs at that location.
+			srcDir := t.TempDir()
+			manifest := fmt.Sprintf(`{"version":1,"catalogs":[{"reference":%q,"name":%q,"tag":%q,"digest":%q}]}`,
+				tc.entry.Reference, tc.entry.Name, tc.entry.Tag, tc.entry.Digest)
+			require.NoError(t, os.WriteFile(filepath.Join(srcDir, "lumen-cache.json"), []byte(manifest), 0644))
+			archivePath := filepath.Join(t.TempDir(), "evil.tar")
+			out, err := os.Create(archivePath)
+			require.NoError(t, err)
+			require.NoError(t, fs.TarDirectory(srcDir, out))
+			require.NoError(t, out.Close())
+
+			workDir := filepath.Join(t.TempDir(), "a", "b")
+			require.NoError(t, os.MkdirAll(workDir, 0755))
+			chdir(t, workDir)
+
+			cataloger := catalog.NewCataloger(logger, catalogMock.NewMockImager(ctrl), fs)
+			entries, err := cataloger.ImportCache(archivePath)
+
+			assert.Nil(t, entries)
+			assert.EqualError(t, err, fmt.Sprintf("invalid cache bundle %s: %s", archivePath, tc.expectedError))
+			assert.NoDirExists(t, filepath.Join(workDir, "..", "escape"))
+		})
+	}
+}
@@ -7,7 +7,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
-	"strings"
+	"time"
 
 	"github.com/containers/image/v5/transports/alltransports"
 	"github.com/containers/image/v5/types"
@@ -31,16 +31,47 @@ func NewCataloger(log Logger, imager Imager, fsio FsIO) *Cataloger {
 	}
 }
 
+// CatalogConfig returns the declarative config of a catalog image, pulling and
+// caching it first if it is not already available locally. When the registry
+// cannot be reached, a previously cached or imported copy of the catalog is used.
 func (c *Cataloger) CatalogConfig(imageRef string) (*declcfg.DeclarativeConfig, error) {
+	entry, err := c.cachedCatalog(imageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	fsys := os.DirFS(entry.configsPath())
+
+	c.log.Debug("Loading declarative config from filesystem...")
+	cfg, err := declcfg.LoadFS(context.Background(), fsys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load declarative config: %w", err)
+	}
+	c.log.Debug("Successfully loaded catalog config.")
+	return cfg, nil
+}
+
+// cachedCatalog makes sure the catalog referenced by imageRef is present in the
+// local cache and returns its cache entry.
+func (c *Cataloger) cachedCatalog(imageRef string) (*CacheEntry, error) {
 	name, tag, digest, err := c.imager.RemoteInfo(imageRef)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get remote info for %s: %w", imageRef, err)
+		entry, lookupErr := lookupCacheEntry(imageRef)
+		if lookupErr != nil {
+			return nil, fmt.Errorf("failed to get remote info for %s: %w", imageRef, err)
+		}
+		c.log.Infof("Could not reach the registry for %s, using cached catalog %s", imageRef, entry.Digest)
+		return entry, nil
+	}
+
+	entry := &CacheEntry{
+		Reference: imageRef,
+		Name:      name,
+		Tag:       tag,
+		Digest:    digest,
 	}
 
-	// TODO not sure if this safeDigest is the correct one to use.
-	// from the tests, it seems that the digest is not the correct one to use.
-	safeDigest := strings.Replace(digest.String(), ":", "-", 1)
-	configsCachePath := filepath.Join("working-dir", "operator-catalogs", name, tag, safeDigest, "configs")
+	configsCachePath := entry.configsPath()
 	baseCachePath := filepath.Dir(configsCachePath)
 
 	c.log.Debugf("Checking for cached catalog at %s...", configsCachePath)
@@ -84,15 +115,16 @@ func (c *Cataloger) CatalogConfig(imageRef string) (*declcfg.DeclarativeConfig,
 		c.log.Debug("Cache hit. Loading catalog from existing directory.")
 	}
 
-	fsys := os.DirFS(configsCachePath)
-
-	c.log.Debug("Loading declarative config from filesystem...")
-	cfg, err := declcfg.LoadFS(context.Background(), fsys)
-	if err != nil {
-		return nil, fmt.Errorf("failed to load declarative config: %w", err)
+	// Record which reference and digest the cached configs belong to, so the
+	// catalog can be found again without a registry and exported to other machines.
+	if cached, err := readCacheEntry(baseCachePath); err == nil {
+		return cached, nil
 	}
-	c.log.Debug("Successfully loaded catalog config.")
-	return cfg, nil
+	entry.CachedAt = time.Now().UTC()
+	if err := writeCacheEntry(entry); err != nil {
+		return nil, err
+	}
+	return entry, nil
 }
 
 func extractCatalogConfig(fsSvc FsIO, ociLayoutDir, tmpDir string) (string, error) {
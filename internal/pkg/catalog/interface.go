@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 )
 
 // Logger defines the interface this package expects for logging.
@@ -20,10 +21,13 @@ type Logger interface {
 type Imager interface {
 	RemoteInfo(imageRef string) (string, string, digest.Digest, error)
 	CopyToOci(imageRef, ociDir string) (string, error)
+	CopyImage(srcRef, destRef, arch string) (string, error)
+	AppendLayer(ociDir string, layer io.Reader, config ociv1.ImageConfig) (string, error)
 }
 
 // FsIO defines the interface this package expects for filesystem I/O.
 type FsIO interface {
 	CopyDirectory(src, dst string) error
 	UntarFromStream(r io.Reader, dest string) error
+	TarDirectory(src string, w io.Writer) error
 }
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	digest "github.com/opencontainers/go-digest"
+	v1 "github.com/opencontainers/image-spec/specs-go/v1"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -131,6 +132,36 @@ func (m *MockImager) EXPECT() *MockImagerMockRecorder {
 	return m.recorder
 }
 
+// AppendLayer mocks base method.
+func (m *MockImager) AppendLayer(ociDir string, layer io.Reader, config v1.ImageConfig) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AppendLayer", ociDir, layer, config)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AppendLayer indicates an expected call of AppendLayer.
+func (mr *MockImagerMockRecorder) AppendLayer(ociDir, layer, config any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AppendLayer", reflect.TypeOf((*MockImager)(nil).AppendLayer), ociDir, layer, config)
+}
+
+// CopyImage mocks base method.
+func (m *MockImager) CopyImage(srcRef, destRef, arch string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyImage", srcRef, destRef, arch)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyImage indicates an expected call of CopyImage.
+func (mr *MockImagerMockRecorder) CopyImage(srcRef, destRef, arch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyImage", reflect.TypeOf((*MockImager)(nil).CopyImage), srcRef, destRef, arch)
+}
+
 // CopyToOci mocks base method.
 func (m *MockImager) CopyToOci(imageRef, ociDir string) (string, error) {
 	m.ctrl.T.Helper()
@@ -201,6 +232,20 @@ func (mr *MockFsIOMockRecorder) CopyDirectory(src, dst any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyDirectory", reflect.TypeOf((*MockFsIO)(nil).CopyDirectory), src, dst)
 }
 
+// TarDirectory mocks base method.
+func (m *MockFsIO) TarDirectory(src string, w io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TarDirectory", src, w)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TarDirectory indicates an expected call of TarDirectory.
+func (mr *MockFsIOMockRecorder) TarDirectory(src, w any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TarDirectory", reflect.TypeOf((*MockFsIO)(nil).TarDirectory), src, w)
+}
+
 // UntarFromStream mocks base method.
 func (m *MockFsIO) UntarFromStream(r io.Reader, dest string) error {
 	m.ctrl.T.Helper()
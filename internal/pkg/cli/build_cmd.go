@@ -0,0 +1,46 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+	"github.com/spf13/cobra"
+)
+
+// NewBuildCmd creates a new build command.
+func NewBuildCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "build",
+		Short: "Build a catalog image from a file-based catalog directory.",
+		Long: `Build a catalog image without a container engine. The FBC directory is validated and added to the
+base image as a /configs layer, with the operators.operatorframework.io.index.configs.v1 label and
+the opm serve command, as opm generate dockerfile would. The base image is a registry reference, a
+local OCI layout directory or a transport qualified reference such as oci:./opm:latest.
+The image is written to an oci:<dir>[:tag] layout or an oci-archive:<file>[:tag] archive and, with
+--push, also pushed to a registry using the credentials of podman or docker login.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var buildOpts catalog.BuildOptions
+			buildOpts.FromDir, _ = cmd.Flags().GetString("from-dir")
+			buildOpts.Base, _ = cmd.Flags().GetString("base")
+			buildOpts.Output, _ = cmd.Flags().GetString("output")
+			buildOpts.Push, _ = cmd.Flags().GetString("push")
+			buildOpts.Arch, _ = cmd.Flags().GetString("arch")
+			built, err := opts.cataloger.BuildImage(buildOpts)
+			if err != nil {
+				return err
+			}
+			opts.printer.PrintBuiltImage(built)
+			return nil
+		},
+	}
+
+	cmd.Flags().String("from-dir", "", "The file-based catalog directory to build the image from")
+	cmd.Flags().String("base", "", "The opm base image, registry reference or OCI layout (e.g., quay.io/operator-framework/opm:latest)")
+	cmd.Flags().StringP("output", "o", "", "The oci: layout or oci-archive: reference to write the image to (e.g., oci:./out:latest)")
+	cmd.Flags().String("push", "", "The registry reference to also push the image to")
+	cmd.Flags().String("arch", "", "The architecture to pick from multi-architecture base images, the host one by default")
+	cmd.MarkFlagRequired("from-dir")
+	cmd.MarkFlagRequired("base")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
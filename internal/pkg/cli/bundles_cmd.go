@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"slices"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
 	"github.com/spf13/cobra"
 )
 
@@ -9,19 +12,36 @@ func NewBundlesCmd(opts *LumenOptions) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "bundles",
 		Short: "List all bundle versions in a channel.",
-		Long:  "List all available bundle versions for a specific channel of an operator.",
+		Long:  "List all available bundle versions for a specific channel of an operator, sorted by semantic version, oldest first.",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			catalog, _ := cmd.Flags().GetString("catalog")
 			pkg, _ := cmd.Flags().GetString("package")
 			channel, _ := cmd.Flags().GetString("channel")
+			wide, _ := cmd.Flags().GetBool("wide")
+			hideDeprecated, _ := cmd.Flags().GetBool("hide-deprecated")
+			arch, _ := cmd.Flags().GetString("arch")
+			var filter list.BundleFilter
+			filter.MinVersion, _ = cmd.Flags().GetString("min-version")
+			filter.MaxVersion, _ = cmd.Flags().GetString("max-version")
+			filter.Latest, _ = cmd.Flags().GetInt("latest")
 
 			bundles, err := opts.lister.BundleVersionsByChannel(catalog, pkg, channel)
 			if err != nil {
 				return err
 			}
+			if hideDeprecated {
+				bundles = slices.DeleteFunc(bundles, func(b list.ChannelEntry) bool { return b.Deprecation != "" })
+			}
+			if arch != "" {
+				bundles = slices.DeleteFunc(bundles, func(b list.ChannelEntry) bool { return !slices.Contains(b.Architectures, arch) })
+			}
+			bundles, err = list.FilterBundles(bundles, filter)
+			if err != nil {
+				return err
+			}
 
-			opts.printer.PrintBundles(pkg, channel, bundles)
+			opts.printer.PrintBundles(pkg, channel, bundles, wide)
 			return nil
 		},
 	}
@@ -29,6 +49,12 @@ func NewBundlesCmd(opts *LumenOptions) *cobra.Command {
 	cmd.Flags().StringP("catalog", "c", "", "The catalog image to list bundles from")
 	cmd.Flags().StringP("package", "p", "", "The package to list bundles for")
 	cmd.Flags().StringP("channel", "C", "", "The channel to list bundles for")
+	cmd.Flags().BoolP("wide", "w", false, "Show the version, replaces, skips, skipRange, architectures, operating systems and image of each bundle")
+	cmd.Flags().Bool("hide-deprecated", false, "Hide deprecated bundles")
+	cmd.Flags().String("arch", "", "Only list bundles supporting this architecture, e.g. arm64")
+	cmd.Flags().String("min-version", "", "Only list bundles with this version or newer")
+	cmd.Flags().String("max-version", "", "Only list bundles with this version or older")
+	cmd.Flags().Int("latest", 0, "Only list the given number of newest bundles")
 	cmd.MarkFlagRequired("catalog")
 	cmd.MarkFlagRequired("package")
 	cmd.MarkFlagRequired("channel")
@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCacheCmd creates a new cache command.
+func NewCacheCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Manage the local catalog cache.",
+		Long:  "Manage the local catalog cache, such as exporting cached catalogs to a bundle and importing them on a disconnected machine.",
+	}
+
+	cmd.AddCommand(NewCacheExportCmd(opts))
+	cmd.AddCommand(NewCacheImportCmd(opts))
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCacheExportCmd creates a new cache export command.
+func NewCacheExportCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export cached catalogs to a bundle for air-gapped transfer.",
+		Long: `Export one or more catalogs to a tar bundle. The bundle carries the extracted FBC,
+the tag to digest mapping and the cache metadata, so it can be imported with 'lumen cache import'
+on a disconnected machine and queried there without any registry. Catalogs that are not cached yet are pulled first.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalogs, _ := cmd.Flags().GetStringSlice("catalog")
+			output, _ := cmd.Flags().GetString("output")
+			entries, err := opts.cataloger.ExportCache(catalogs, output)
+			if err != nil {
+				return err
+			}
+			opts.printer.PrintCacheEntries(entries)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringSliceP("catalog", "c", nil, "The catalog image to export, can be repeated")
+	cmd.Flags().StringP("output", "o", "", "The path of the bundle to write (e.g., catalogs.tar)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
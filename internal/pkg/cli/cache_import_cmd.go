@@ -0,0 +1,25 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCacheImportCmd creates a new cache import command.
+func NewCacheImportCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import <bundle>",
+		Short: "Import a bundle of cached catalogs.",
+		Long:  "Import a bundle created by 'lumen cache export' into the local cache, so its catalogs can be queried without access to a registry.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			entries, err := opts.cataloger.ImportCache(args[0])
+			if err != nil {
+				return err
+			}
+			opts.printer.PrintCacheEntries(entries)
+			return nil
+		},
+	}
+
+	return cmd
+}
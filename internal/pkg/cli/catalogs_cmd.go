@@ -14,7 +14,7 @@ func NewCatalogsCmd(opts *LumenOptions) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			ocpVersion, _ := cmd.Flags().GetString("ocp-version")
 
-			catalogs, err := opts.lister.Catalogs(ocpVersion)
+			catalogs, err := opts.lister.Catalogs(ocpVersion, opts.catalogSources)
 			if err != nil {
 				return err
 			}
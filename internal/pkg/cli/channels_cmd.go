@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"slices"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
 	"github.com/spf13/cobra"
 )
 
@@ -14,11 +17,15 @@ func NewChannelsCmd(opts *LumenOptions) *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			catalog, _ := cmd.Flags().GetString("catalog")
 			pkg, _ := cmd.Flags().GetString("package")
+			hideDeprecated, _ := cmd.Flags().GetBool("hide-deprecated")
 
 			channels, err := opts.lister.ChannelsByPackage(catalog, pkg)
 			if err != nil {
 				return err
 			}
+			if hideDeprecated {
+				channels = slices.DeleteFunc(channels, func(ch list.Channel) bool { return ch.Deprecation != "" })
+			}
 
 			opts.printer.PrintChannels(channels)
 			return nil
@@ -27,6 +34,7 @@ func NewChannelsCmd(opts *LumenOptions) *cobra.Command {
 
 	cmd.Flags().StringP("catalog", "c", "", "The catalog image to list channels from")
 	cmd.Flags().StringP("package", "p", "", "The package to list channels for")
+	cmd.Flags().Bool("hide-deprecated", false, "Hide deprecated channels")
 	cmd.MarkFlagRequired("catalog")
 	cmd.MarkFlagRequired("package")
 	return cmd
@@ -2,13 +2,18 @@ package cli_test
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
 	"github.com/aguidirh/lumen/internal/pkg/cli"
 	cliMock "github.com/aguidirh/lumen/internal/pkg/cli/mock"
 	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/opencontainers/go-digest"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 )
 
@@ -19,7 +24,7 @@ func TestNewLumenCmd(t *testing.T) {
 	mockLister := cliMock.NewMockLister(ctrl)
 	mockPrinter := cliMock.NewMockPrinter(ctrl)
 
-	cmd := cli.NewLumenCmd(mockLister, mockPrinter)
+	cmd := cli.NewLumenCmd(mockLister, mockPrinter, nil)
 	assert.NotNil(t, cmd)
 	assert.Equal(t, "lumen", cmd.Use)
 	assert.NotEmpty(t, cmd.Short)
@@ -48,13 +53,13 @@ func TestNewCatalogsCmd(t *testing.T) {
 	mockPrinter := cliMock.NewMockPrinter(ctrl)
 
 	// Test successful execution
-	catalogs := []string{"catalog1", "catalog2"}
+	catalogs := []list.Catalog{{Reference: "catalog1:v4.15", Source: "catalog1"}, {Reference: "catalog2:v4.15", Source: "catalog2"}}
 	version := "4.15"
 
-	mockLister.EXPECT().Catalogs(version).Return(catalogs, nil)
+	mockLister.EXPECT().Catalogs(version, nil).Return(catalogs, nil)
 	mockPrinter.EXPECT().PrintCatalogs(version, catalogs)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewCatalogsCmd(opts)
 	cmd.SetArgs([]string{"--ocp-version", version})
 
@@ -73,7 +78,7 @@ func TestNewCatalogsCmd_MissingVersion(t *testing.T) {
 	mockLister := cliMock.NewMockLister(ctrl)
 	mockPrinter := cliMock.NewMockPrinter(ctrl)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewCatalogsCmd(opts)
 	cmd.SetArgs([]string{})
 
@@ -101,9 +106,9 @@ func TestNewPackagesCmd(t *testing.T) {
 	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
 
 	mockLister.EXPECT().PackagesByCatalog(catalogRef).Return(packages, nil)
-	mockPrinter.EXPECT().PrintPackages(packages)
+	mockPrinter.EXPECT().PrintPackages(packages, false)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewPackagesCmd(opts)
 	cmd.SetArgs([]string{"--catalog", catalogRef})
 
@@ -122,7 +127,7 @@ func TestNewPackagesCmd_MissingCatalog(t *testing.T) {
 	mockLister := cliMock.NewMockLister(ctrl)
 	mockPrinter := cliMock.NewMockPrinter(ctrl)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewPackagesCmd(opts)
 	cmd.SetArgs([]string{})
 
@@ -153,7 +158,7 @@ func TestNewChannelsCmd(t *testing.T) {
 	mockLister.EXPECT().ChannelsByPackage(catalogRef, packageName).Return(channels, nil)
 	mockPrinter.EXPECT().PrintChannels(channels)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewChannelsCmd(opts)
 	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", packageName})
 
@@ -192,7 +197,7 @@ func TestNewChannelsCmd_MissingFlags(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			opts := cli.NewLumenOptions(mockLister, mockPrinter)
+			opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 			cmd := cli.NewChannelsCmd(opts)
 			cmd.SetArgs(tt.args)
 
@@ -224,9 +229,9 @@ func TestNewBundlesCmd(t *testing.T) {
 	channelName := "stable"
 
 	mockLister.EXPECT().BundleVersionsByChannel(catalogRef, packageName, channelName).Return(bundles, nil)
-	mockPrinter.EXPECT().PrintBundles(packageName, channelName, bundles)
+	mockPrinter.EXPECT().PrintBundles(packageName, channelName, bundles, false)
 
-	opts := cli.NewLumenOptions(mockLister, mockPrinter)
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 	cmd := cli.NewBundlesCmd(opts)
 	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", packageName, "--channel", channelName})
 
@@ -238,6 +243,33 @@ func TestNewBundlesCmd(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestNewBundlesCmd_Wide(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	bundles := []list.ChannelEntry{
+		{Name: "package.v1.0.0", Version: "1.0.0", Image: "quay.io/package:v1.0.0"},
+		{Name: "package.v1.1.0", Version: "1.1.0", Image: "quay.io/package:v1.1.0", Replaces: "package.v1.0.0"},
+	}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().BundleVersionsByChannel(catalogRef, "test-package", "stable").Return(bundles, nil)
+	mockPrinter.EXPECT().PrintBundles("test-package", "stable", bundles, true)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewBundlesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable", "--wide"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
 func TestNewBundlesCmd_MissingFlags(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -269,7 +301,7 @@ func TestNewBundlesCmd_MissingFlags(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			opts := cli.NewLumenOptions(mockLister, mockPrinter)
+			opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
 			cmd := cli.NewBundlesCmd(opts)
 			cmd.SetArgs(tt.args)
 
@@ -291,7 +323,7 @@ func TestCLICommandStructure(t *testing.T) {
 	lister := cliMock.NewMockLister(ctrl)
 	printer := cliMock.NewMockPrinter(ctrl)
 
-	cmd := cli.NewLumenCmd(lister, printer)
+	cmd := cli.NewLumenCmd(lister, printer, nil)
 	listCmd, _, err := cmd.Find([]string{"list"})
 	assert.NoError(t, err)
 
@@ -334,3 +366,1012 @@ func TestCLICommandStructure(t *testing.T) {
 	channelFlag := bundlesCmd.Flags().Lookup("channel")
 	assert.NotNil(t, channelFlag)
 }
+
+func TestNewCacheExportCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+	mockCataloger := cliMock.NewMockCataloger(ctrl)
+
+	catalogRefs := []string{
+		"registry.redhat.io/redhat/redhat-operator-index:v4.15",
+		"registry.redhat.io/redhat/certified-operator-index:v4.15",
+	}
+	entries := []catalog.CacheEntry{
+		{Reference: catalogRefs[0], Digest: digest.FromString("redhat")},
+		{Reference: catalogRefs[1], Digest: digest.FromString("certified")},
+	}
+	mockCataloger.EXPECT().ExportCache(catalogRefs, "catalogs.tar").Return(entries, nil)
+	mockPrinter.EXPECT().PrintCacheEntries(entries)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, mockCataloger)
+	cmd := cli.NewCacheExportCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRefs[0], "--catalog", catalogRefs[1], "-o", "catalogs.tar"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewCacheExportCmd_MissingFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), cliMock.NewMockCataloger(ctrl))
+	cmd := cli.NewCacheExportCmd(opts)
+	cmd.SetArgs([]string{"--catalog", "registry.redhat.io/redhat/redhat-operator-index:v4.15"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewCacheImportCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+	mockCataloger := cliMock.NewMockCataloger(ctrl)
+
+	entries := []catalog.CacheEntry{
+		{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.15", Digest: digest.FromString("redhat")},
+	}
+	mockCataloger.EXPECT().ImportCache("catalogs.tar").Return(entries, nil)
+	mockPrinter.EXPECT().PrintCacheEntries(entries)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, mockCataloger)
+	cmd := cli.NewCacheImportCmd(opts)
+	cmd.SetArgs([]string{"catalogs.tar"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+
+	// The bundle path is mandatory.
+	cmd = cli.NewCacheImportCmd(opts)
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	assert.Error(t, cmd.Execute())
+}
+
+func TestNewShowPackageCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	details := &list.PackageDetails{Name: "test-package", Channel: "fast"}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().PackageDetails(catalogRef, "test-package", "fast").Return(details, nil)
+	mockPrinter.EXPECT().PrintPackageDetails(details, "table").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewShowPackageCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--channel", "fast", "test-package"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewShowBundleCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	details := &list.BundleDetails{Name: "package.v1.0.0", Package: "test-package"}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().BundleDetails(catalogRef, "test-package", "package.v1.0.0").Return(details, nil)
+	mockPrinter.EXPECT().PrintBundleDetails(details, "yaml").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewShowBundleCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "-o", "yaml", "package.v1.0.0"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewShowBundleCmd_MissingArgs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	tests := []struct {
+		name string
+		args []string
+	}{
+		{
+			name: "missing bundle name",
+			args: []string{"--catalog", "registry.redhat.io/redhat/redhat-operator-index:v4.15", "--package", "test-package"},
+		},
+		{
+			name: "missing package",
+			args: []string{"--catalog", "registry.redhat.io/redhat/redhat-operator-index:v4.15", "package.v1.0.0"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+			cmd := cli.NewShowBundleCmd(opts)
+			cmd.SetArgs(tt.args)
+
+			var buf bytes.Buffer
+			cmd.SetOut(&buf)
+			cmd.SetErr(&buf)
+
+			assert.Error(t, cmd.Execute())
+		})
+	}
+}
+
+func TestNewGraphCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	graph := &list.UpgradeGraph{Package: "test-package", DefaultChannel: "stable"}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().UpgradeGraph(catalogRef, "test-package", "stable").Return(graph, nil)
+	mockPrinter.EXPECT().PrintGraph(graph, "mermaid").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewGraphCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable", "-o", "mermaid"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewGraphCmd_DefaultsToAllChannelsAsDOT(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	graph := &list.UpgradeGraph{Package: "test-package", DefaultChannel: "stable"}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().UpgradeGraph(catalogRef, "test-package", "").Return(graph, nil)
+	mockPrinter.EXPECT().PrintGraph(graph, "dot").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewGraphCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewUpgradePathCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	path := &list.UpgradePath{Package: "test-package", Channel: "stable", From: "package.v1.0.0", To: "package.v1.1.0"}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	mockLister.EXPECT().UpgradePath(catalogRef, "test-package", "stable", "package.v1.0.0", "").Return(path, nil)
+	mockPrinter.EXPECT().PrintUpgradePath(path, "table").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewUpgradePathCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable", "--from", "package.v1.0.0"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewUpgradePathCmd_MissingFrom(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewUpgradePathCmd(opts)
+	cmd.SetArgs([]string{"--catalog", "registry.redhat.io/redhat/redhat-operator-index:v4.15", "--package", "test-package", "--channel", "stable"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewSearchCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	results := []list.SearchResult{{Catalog: "catalog:v4.15", Package: "cluster-logging", Score: 40}}
+	catalogs := []string{"registry.redhat.io/redhat/redhat-operator-index:v4.15", "registry.redhat.io/redhat/certified-operator-index:v4.15"}
+	mockLister.EXPECT().Catalogs("4.15", nil).Return([]list.Catalog{{Reference: catalogs[0], Source: "redhat"}, {Reference: catalogs[1], Source: "certified"}}, nil)
+	mockLister.EXPECT().Search("cluster logging", catalogs).Return(results, nil)
+	mockPrinter.EXPECT().PrintSearchResults(results, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewSearchCmd(opts)
+	cmd.SetArgs([]string{"cluster", "logging", "--ocp-version", "4.15", "-o", "json"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewSearchCmd_MissingCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewSearchCmd(opts)
+	cmd.SetArgs([]string{"logging"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one of the flags in the group [catalog ocp-version] is required")
+}
+
+func TestNewFindCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	matches := []list.APIMatch{{Catalog: "catalog:v4.18", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.41.0", Relation: list.RelationRequires}}
+	catalogs := []string{"catalog:v4.18", "registry.redhat.io/redhat/redhat-operator-index:v4.18"}
+	mockLister.EXPECT().Catalogs("4.18", nil).Return([]list.Catalog{{Reference: catalogs[1], Source: "redhat"}}, nil)
+	mockLister.EXPECT().FindAPI(catalogs, "KafkaTopic.kafka.strimzi.io/v1beta2", list.RelationRequires).Return(matches, nil)
+	mockPrinter.EXPECT().PrintAPIMatches(matches, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewFindCmd(opts)
+	cmd.SetArgs([]string{"--requires", "KafkaTopic.kafka.strimzi.io/v1beta2", "-c", "catalog:v4.18", "-v", "4.18", "-o", "json"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewFindCmd_ProvidesAndRequires(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewFindCmd(opts)
+	cmd.SetArgs([]string{"--provides", "KafkaTopic", "--requires", "Kafka", "-c", "catalog:v4.18"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "if any flags in the group [provides requires] are set none of the others can be")
+}
+
+func TestNewDepsCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	tree := &list.DependencyTree{Catalog: "catalog:v4.18", Root: list.DependencyNode{Package: "app", Bundle: "app.v0.9.0"}}
+	mockLister.EXPECT().Dependencies("catalog:v4.18", "app", "app.v0.9.0").Return(tree, nil)
+	mockPrinter.EXPECT().PrintDependencies(tree, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewDepsCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18", "-p", "app", "--bundle", "app.v0.9.0", "-o", "json"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewCompatCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	report := &list.CompatibilityReport{Catalog: "catalog:v4.16", TargetOCP: "4.18", TargetKube: "1.31"}
+	mockLister.EXPECT().Compatibility("catalog:v4.16", "4.18", []string{"legacy-operator", "modern-operator"}).Return(report, nil)
+	mockPrinter.EXPECT().PrintCompatibility(report, "table").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewCompatCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.16", "--target-ocp", "4.18", "-p", "legacy-operator", "-p", "modern-operator"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewCompatCmd_MissingTarget(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewCompatCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.16"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), `required flag(s) "target-ocp" not set`)
+}
+
+func TestNewWhereCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	locations := &list.PackageLocations{Package: "grafana-operator", OCPVersion: "4.16"}
+	mockLister.EXPECT().Where("grafana-operator", "4.16", nil).Return(locations, nil)
+	mockPrinter.EXPECT().PrintPackageLocations(locations, "yaml").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewWhereCmd(opts)
+	cmd.SetArgs([]string{"grafana-operator", "--ocp-version", "4.16", "-o", "yaml"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewMatrixCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	matrix := &list.PackageMatrix{Package: "logging"}
+	mockLister.EXPECT().Matrix("logging", []string{"4.12", "4.13", "4.14"}, nil).Return(matrix, nil)
+	mockPrinter.EXPECT().PrintPackageMatrix(matrix, "csv").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewMatrixCmd(opts)
+	cmd.SetArgs([]string{"--package", "logging", "--ocp-versions", "4.12-4.14", "-o", "csv"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewMatrixCmd_InvalidVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewMatrixCmd(opts)
+	cmd.SetArgs([]string{"--package", "logging", "--ocp-versions", "4.18-4.12"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid OpenShift version range "4.18-4.12", 4.12 is older than 4.18`)
+}
+
+func TestNewQueryCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	expr := "[.channels[].entries[] | select(.skipRange and (.replaces | not))]"
+	results := []any{[]any{map[string]any{"name": "app.v1.2.0", "skipRange": "<1.2.0"}}}
+	mockLister.EXPECT().Query("catalog:v4.18", expr).Return(results, nil)
+	mockPrinter.EXPECT().PrintQueryResults(results, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewQueryCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18", expr})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewFilterCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	filters := []list.PackageFilter{
+		{Package: "jaeger"},
+		{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.1", MaxVersion: "5.8.3"},
+	}
+	filtered := &list.FilteredCatalog{Catalog: "catalog:v4.18", OutputDir: "./out"}
+	mockLister.EXPECT().Filter("catalog:v4.18", filters, "./out").Return(filtered, nil)
+	mockPrinter.EXPECT().PrintFilteredCatalog(filtered)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewFilterCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18", "--include-package", "jaeger", "--include-package", "logging:stable-5.8@5.8.1-5.8.3", "-o", "./out"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewFilterCmd_InvalidFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewFilterCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18", "-p", "logging:stable@latest", "-o", "./out"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid version range "latest" in package filter "logging:stable@latest", expected min-max`)
+}
+
+func TestNewBuildCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+	mockCataloger := cliMock.NewMockCataloger(ctrl)
+
+	buildOpts := catalog.BuildOptions{
+		FromDir: "./fbc",
+		Base:    "quay.io/operator-framework/opm:latest",
+		Output:  "oci:./out:v1",
+		Push:    "registry.example.com/catalog:v1",
+		Arch:    "arm64",
+	}
+	built := &catalog.BuiltImage{Base: buildOpts.Base, Output: buildOpts.Output, Push: buildOpts.Push, Digest: "sha256:abc", Packages: 1}
+	mockCataloger.EXPECT().BuildImage(buildOpts).Return(built, nil)
+	mockPrinter.EXPECT().PrintBuiltImage(built)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, mockCataloger)
+	cmd := cli.NewBuildCmd(opts)
+	cmd.SetArgs([]string{"--from-dir", "./fbc", "--base", buildOpts.Base, "-o", buildOpts.Output, "--push", buildOpts.Push, "--arch", "arm64"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewBuildCmd_MissingFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), cliMock.NewMockCataloger(ctrl))
+	cmd := cli.NewBuildCmd(opts)
+	cmd.SetArgs([]string{"--from-dir", "./fbc", "-o", "oci:./out"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewGenerateImageSetConfigCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	filters := []list.PackageFilter{
+		{Package: "jaeger"},
+		{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.1", MaxVersion: "5.8.3"},
+	}
+	config := &list.ImageSetConfiguration{Kind: list.ImageSetConfigKind, APIVersion: list.ImageSetConfigAPIVersion}
+	mockLister.EXPECT().ImageSetConfig("catalog:v4.18", filters).Return(config, nil)
+	mockPrinter.EXPECT().PrintImageSetConfig(config, "yaml").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewGenerateCmd(opts)
+	cmd.SetArgs([]string{"imageset-config", "-c", "catalog:v4.18", "--package", "jaeger", "--package", "logging:stable-5.8@5.8.1-5.8.3"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewGenerateImageSetConfigCmd_InvalidFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewGenerateImageSetConfigCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18", "-p", ":stable"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `invalid package filter ":stable", expected package[:channel[@min-max]]`)
+}
+
+func TestNewGenerateSubscriptionCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	subOpts := list.SubscriptionOptions{
+		Package:       "cluster-logging",
+		Channel:       "stable-5.8",
+		StartingCSV:   "cluster-logging.v5.8.1",
+		Approval:      "Manual",
+		CatalogSource: true,
+	}
+	manifests := []list.Manifest{{APIVersion: "v1", Kind: "Namespace", Metadata: list.ManifestMetadata{Name: "openshift-logging"}}}
+	mockLister.EXPECT().SubscriptionManifests("catalog:v4.18", subOpts).Return(manifests, nil)
+	mockPrinter.EXPECT().PrintManifests(manifests, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewGenerateCmd(opts)
+	cmd.SetArgs([]string{"subscription", "-c", "catalog:v4.18", "-p", "cluster-logging", "--channel", "stable-5.8",
+		"--starting-csv", "cluster-logging.v5.8.1", "--approval", "Manual", "--with-catalog-source", "-o", "json"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewGenerateSubscriptionCmd_CatalogSourceSelector(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// The global --catalog-source selector must not be shadowed by the subscription flags.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	subOpts := list.SubscriptionOptions{Package: "cluster-logging", Approval: "Automatic", CatalogSource: true}
+	mockLister.EXPECT().SubscriptionManifests("catalog:v4.18", subOpts).Return(nil, nil)
+	mockPrinter.EXPECT().PrintManifests(gomock.Nil(), "yaml").Return(nil)
+
+	cmd := cli.NewLumenCmd(mockLister, mockPrinter, nil)
+	cmd.SetArgs([]string{"generate", "subscription", "-c", "catalog:v4.18", "-p", "cluster-logging",
+		"--with-catalog-source", "--catalog-source", "redhat"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewGenerateSubscriptionCmd_MissingPackage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewGenerateSubscriptionCmd(opts)
+	cmd.SetArgs([]string{"-c", "catalog:v4.18"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewRelatedImagesCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	images := []list.RelatedImage{{Image: "quay.io/example/operator@sha256:aaaa", Bundles: []string{"package.v1.0.0"}}}
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	filter := list.RelatedImagesFilter{Package: "test-package", Channel: "stable", MinVersion: "1.0.0", MaxVersion: "1.2.0"}
+	mockLister.EXPECT().RelatedImages(catalogRef, filter).Return(images, nil)
+	mockPrinter.EXPECT().PrintRelatedImages(images, "mapping", "mirror.example.com").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewRelatedImagesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable",
+		"--min-version", "1.0.0", "--max-version", "1.2.0", "-o", "mapping", "--mirror-registry", "mirror.example.com"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewRelatedImagesCmd_MissingCatalog(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewRelatedImagesCmd(opts)
+	cmd.SetArgs([]string{"--package", "test-package"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewDiffCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	from := "registry.redhat.io/redhat/redhat-operator-index@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11"
+	to := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	diff := &list.CatalogDiff{From: from, To: to, AddedPackages: []string{"test-package"}}
+	mockLister.EXPECT().Diff(from, to, []string{"test-package", "other-package"}).Return(diff, nil)
+	mockPrinter.EXPECT().PrintDiff(diff, "markdown").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewDiffCmd(opts)
+	cmd.SetArgs([]string{"--from", from, "--to", to, "-p", "test-package,other-package", "-o", "markdown"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewDiffCmd_MissingTo(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	opts := cli.NewLumenOptions(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd := cli.NewDiffCmd(opts)
+	cmd.SetArgs([]string{"--from", "registry.redhat.io/redhat/redhat-operator-index:v4.14"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "required flag(s)")
+}
+
+func TestNewLumenCmd_CatalogSourcesFromConfig(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	configPath := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(`
+catalogSources:
+- name: okd
+  label: OKD Community
+  repository: quay.io/okd/community-index
+  tagTemplate: "{major}.{minor}"
+- name: internal
+  repository: registry.example.com/catalogs/internal
+`), 0644))
+
+	sources := []list.CatalogSource{{Name: "okd", Label: "OKD Community", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}"}}
+	catalogs := []list.Catalog{{Reference: "quay.io/okd/community-index:4.16", Source: "okd", Label: "OKD Community"}}
+	mockLister.EXPECT().Catalogs("4.16", sources).Return(catalogs, nil)
+	mockPrinter.EXPECT().PrintCatalogs("4.16", catalogs)
+
+	cmd := cli.NewLumenCmd(mockLister, mockPrinter, nil)
+	cmd.SetArgs([]string{"list", "catalogs", "--ocp-version", "4.16", "--config", configPath, "--catalog-source", "okd"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewLumenCmd_UnknownCatalogSource(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Without a config file, only the Red Hat catalog sources are known.
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cmd := cli.NewLumenCmd(cliMock.NewMockLister(ctrl), cliMock.NewMockPrinter(ctrl), nil)
+	cmd.SetArgs([]string{"list", "catalogs", "--ocp-version", "4.16", "--catalog-source", "partner"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.EqualError(t, err, `unknown catalog source "partner"`)
+}
+
+func TestNewOCPVersionsCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	matrix := &list.OCPVersionMatrix{Versions: []list.OCPVersion{{Version: "4.16"}}}
+	mockLister.EXPECT().OCPVersions(nil).Return(matrix, nil)
+	mockPrinter.EXPECT().PrintOCPVersions(matrix, "json").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewOCPVersionsCmd(opts)
+	cmd.SetArgs([]string{"-o", "json"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewBundlesCmd_HideDeprecated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	bundles := []list.ChannelEntry{{Name: "package.v1.0.0", Deprecation: "data loss bug"}, {Name: "package.v1.1.0"}}
+	mockLister.EXPECT().BundleVersionsByChannel(catalogRef, "test-package", "stable").Return(bundles, nil)
+	mockPrinter.EXPECT().PrintBundles("test-package", "stable", []list.ChannelEntry{{Name: "package.v1.1.0"}}, false)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewBundlesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable", "--hide-deprecated"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewPackagesCmd_HideDeprecated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	packages := []list.Package{{Name: "old-package", Deprecation: "use new-package"}, {Name: "new-package"}}
+	mockLister.EXPECT().PackagesByCatalog(catalogRef).Return(packages, nil)
+	mockPrinter.EXPECT().PrintPackages([]list.Package{{Name: "new-package"}}, false)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewPackagesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--hide-deprecated"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewPackagesCmd_Arch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	packages := []list.Package{
+		{Name: "amd64-package", Architectures: []string{"amd64"}},
+		{Name: "multiarch-package", Architectures: []string{"amd64", "arm64"}},
+		{Name: "unknown-package"},
+	}
+	mockLister.EXPECT().PackagesByCatalog(catalogRef).Return(packages, nil)
+	mockPrinter.EXPECT().PrintPackages([]list.Package{packages[1]}, true)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewPackagesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--arch", "arm64", "--wide"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewBundlesCmd_Arch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	bundles := []list.ChannelEntry{
+		{Name: "package.v1.0.0", Architectures: []string{"amd64"}},
+		{Name: "package.v1.1.0", Architectures: []string{"amd64", "arm64"}},
+	}
+	mockLister.EXPECT().BundleVersionsByChannel(catalogRef, "test-package", "stable").Return(bundles, nil)
+	mockPrinter.EXPECT().PrintBundles("test-package", "stable", []list.ChannelEntry{{Name: "package.v1.1.0", Architectures: []string{"amd64", "arm64"}}}, false)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewBundlesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable", "--arch", "arm64"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewDeprecationsCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	deprecations := []list.Deprecation{{Package: "test-package", Kind: list.DeprecationChannel, Name: "beta", Message: "use stable"}}
+	mockLister.EXPECT().Deprecations(catalogRef, "test-package").Return(deprecations, nil)
+	mockPrinter.EXPECT().PrintDeprecations(deprecations, "table").Return(nil)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewDeprecationsCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
+
+func TestNewBundlesCmd_VersionFilters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLister := cliMock.NewMockLister(ctrl)
+	mockPrinter := cliMock.NewMockPrinter(ctrl)
+
+	catalogRef := "registry.redhat.io/redhat/redhat-operator-index:v4.15"
+	bundles := []list.ChannelEntry{
+		{Name: "package.v1.0.0", Version: "1.0.0"},
+		{Name: "package.v1.1.0", Version: "1.1.0"},
+		{Name: "package.v1.2.0", Version: "1.2.0"},
+		{Name: "package.v2.0.0", Version: "2.0.0"},
+	}
+	mockLister.EXPECT().BundleVersionsByChannel(catalogRef, "test-package", "stable").Return(bundles, nil)
+	mockPrinter.EXPECT().PrintBundles("test-package", "stable", bundles[1:3], false)
+
+	opts := cli.NewLumenOptions(mockLister, mockPrinter, nil)
+	cmd := cli.NewBundlesCmd(opts)
+	cmd.SetArgs([]string{"--catalog", catalogRef, "--package", "test-package", "--channel", "stable",
+		"--min-version", "1.0.0", "--max-version", "1.9.0", "--latest", "2"})
+
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+
+	err := cmd.Execute()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewCompatCmd creates a new compat command.
+func NewCompatCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "compat",
+		Short: "Check the compatibility of operators with an OpenShift version.",
+		Long: `Check whether the head of every channel can run on an OpenShift version, before upgrading a cluster.
+A head is incompatible when its olm.maxOpenShiftVersion property is older than the target version, or
+when the minKubeVersion of its CSV is newer than the Kubernetes version shipped with the target.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			targetOCP, _ := cmd.Flags().GetString("target-ocp")
+			packages, _ := cmd.Flags().GetStringSlice("package")
+			output, _ := cmd.Flags().GetString("output")
+			report, err := opts.lister.Compatibility(catalog, targetOCP, packages)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintCompatibility(report, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to check")
+	cmd.Flags().String("target-ocp", "", "The OpenShift version to check against, e.g. 4.18")
+	cmd.Flags().StringSliceP("package", "p", nil, "Only check these packages, can be repeated")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("target-ocp")
+
+	return cmd
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewDeprecationsCmd creates a new deprecations command.
+func NewDeprecationsCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecations",
+		Short: "List the deprecated packages, channels and bundles of a catalog.",
+		Long:  "List the packages, channels and bundles marked as deprecated by the olm.deprecations blobs of a catalog, with their deprecation message.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			pkg, _ := cmd.Flags().GetString("package")
+			output, _ := cmd.Flags().GetString("output")
+
+			deprecations, err := opts.lister.Deprecations(catalog, pkg)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintDeprecations(deprecations, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to list deprecations from")
+	cmd.Flags().StringP("package", "p", "", "Only list the deprecations of this package")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+	return cmd
+}
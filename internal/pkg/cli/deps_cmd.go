@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewDepsCmd creates a new deps command.
+func NewDepsCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deps",
+		Short: "Show the dependency tree of a bundle.",
+		Long: `Resolve the olm.package.required and olm.gvk.required properties of a bundle against the catalog
+into the tree of operators installed along with it. Requirements are satisfied by the head of the default
+channel of the candidate packages and the ones no bundle satisfies are flagged.
+The head of the default channel of the package is used when --bundle is not set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			pkg, _ := cmd.Flags().GetString("package")
+			bundle, _ := cmd.Flags().GetString("bundle")
+			output, _ := cmd.Flags().GetString("output")
+			tree, err := opts.lister.Dependencies(catalog, pkg, bundle)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintDependencies(tree, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to resolve the dependencies in")
+	cmd.Flags().StringP("package", "p", "", "The package name")
+	cmd.Flags().StringP("bundle", "b", "", "The bundle name, the default channel head when not set")
+	cmd.Flags().StringP("output", "o", "text", "The output format (text, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+
+	return cmd
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewDiffCmd creates a new diff command.
+func NewDiffCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show what changed between two catalogs.",
+		Long: `Compare two catalog images, by tag or digest, and show the packages and channels added or removed,
+the new and removed bundles, and the packages whose default channel or channel heads changed.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, _ := cmd.Flags().GetString("from")
+			to, _ := cmd.Flags().GetString("to")
+			packages, _ := cmd.Flags().GetStringSlice("package")
+			output, _ := cmd.Flags().GetString("output")
+
+			diff, err := opts.lister.Diff(from, to, packages)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintDiff(diff, output)
+		},
+	}
+
+	cmd.Flags().String("from", "", "The catalog image to compare from")
+	cmd.Flags().String("to", "", "The catalog image to compare to")
+	cmd.Flags().StringSliceP("package", "p", nil, "Only compare these packages, can be repeated")
+	cmd.Flags().StringP("output", "o", "text", "The output format (text, markdown, json, yaml)")
+	cmd.MarkFlagRequired("from")
+	cmd.MarkFlagRequired("to")
+	return cmd
+}
@@ -0,0 +1,50 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewFilterCmd creates a new filter command.
+func NewFilterCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "filter",
+		Short: "Write a subset of a catalog as a file-based catalog.",
+		Long: `Write the packages selected with --include-package to a file-based catalog directory, with one
+<package>/catalog.json file per package, ready to be built into a slim catalog image.
+Each --include-package takes the form package[:channel[@min-max]] and selects a whole package, one of
+its channels, or the bundles of a channel within a version range. Channels are trimmed to the kept
+bundles, the default channel is replaced by the newest kept channel when it is filtered out, and
+deprecations are retained. The output directory must not exist or be empty.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			specs, _ := cmd.Flags().GetStringSlice("include-package")
+			output, _ := cmd.Flags().GetString("output")
+			filters := make([]list.PackageFilter, 0, len(specs))
+			for _, spec := range specs {
+				filter, err := list.ParsePackageFilter(spec)
+				if err != nil {
+					return err
+				}
+				filters = append(filters, filter)
+			}
+
+			filtered, err := opts.lister.Filter(catalog, filters, output)
+			if err != nil {
+				return err
+			}
+			opts.printer.PrintFilteredCatalog(filtered)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to filter")
+	cmd.Flags().StringSliceP("include-package", "p", nil, "The package to keep as package[:channel[@min-max]], can be repeated")
+	cmd.Flags().StringP("output", "o", "", "The directory to write the filtered catalog to")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("include-package")
+	cmd.MarkFlagRequired("output")
+
+	return cmd
+}
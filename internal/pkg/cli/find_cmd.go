@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewFindCmd creates a new find command.
+func NewFindCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "find",
+		Short: "Find the operators providing or requiring an API.",
+		Long: `Find the bundles providing or requiring an API across one or more catalogs, by reading their
+olm.gvk and olm.gvk.required properties. The API is given as group/version/Kind, as Kind.group/version,
+as version/Kind for core APIs or as a bare Kind, e.g. KafkaTopic.kafka.strimzi.io/v1beta2. Kinds are
+capitalized, so that a group/version such as apps/v1 is rejected.
+Use --ocp-version to search every catalog of an OpenShift version.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalogs, _ := cmd.Flags().GetStringSlice("catalog")
+			ocpVersion, _ := cmd.Flags().GetString("ocp-version")
+			provides, _ := cmd.Flags().GetString("provides")
+			requires, _ := cmd.Flags().GetString("requires")
+			output, _ := cmd.Flags().GetString("output")
+
+			api, relation := provides, list.RelationProvides
+			if requires != "" {
+				api, relation = requires, list.RelationRequires
+			}
+			catalogs, err := opts.catalogRefs(catalogs, ocpVersion)
+			if err != nil {
+				return err
+			}
+			matches, err := opts.lister.FindAPI(catalogs, api, relation)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintAPIMatches(matches, output)
+		},
+	}
+
+	cmd.Flags().String("provides", "", "Find the bundles providing this API")
+	cmd.Flags().String("requires", "", "Find the bundles requiring this API")
+	cmd.Flags().StringSliceP("catalog", "c", nil, "The catalog images to search, can be repeated")
+	cmd.Flags().StringP("ocp-version", "v", "", "Search every catalog of this OpenShift version")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagsOneRequired("provides", "requires")
+	cmd.MarkFlagsMutuallyExclusive("provides", "requires")
+	cmd.MarkFlagsOneRequired("catalog", "ocp-version")
+
+	return cmd
+}
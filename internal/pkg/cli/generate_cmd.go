@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewGenerateCmd creates a new generate command.
+func NewGenerateCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate manifests from an operator catalog.",
+		Long:  "Generate configuration files and manifests for the operators of a catalog, checked against the catalog content.",
+	}
+
+	cmd.AddCommand(NewGenerateImageSetConfigCmd(opts))
+	cmd.AddCommand(NewGenerateSubscriptionCmd(opts))
+
+	return cmd
+}
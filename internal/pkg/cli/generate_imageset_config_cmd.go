@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewGenerateImageSetConfigCmd creates a new generate imageset-config command.
+func NewGenerateImageSetConfigCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "imageset-config",
+		Short: "Generate an oc-mirror ImageSetConfiguration for packages of a catalog.",
+		Long: `Generate an oc-mirror v2alpha1 ImageSetConfiguration mirroring the packages selected with --package.
+Each --package takes the form package[:channel[@min-max]], as in lumen filter. Packages and channels must
+exist in the catalog and the version bounds must be versions of bundles in the channel. A package without
+a channel mirrors the head of its default channel, as oc-mirror does, and when the selected channels
+leave the default channel out the newest one is set as the defaultChannel of the package.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			specs, _ := cmd.Flags().GetStringSlice("package")
+			output, _ := cmd.Flags().GetString("output")
+			filters := make([]list.PackageFilter, 0, len(specs))
+			for _, spec := range specs {
+				filter, err := list.ParsePackageFilter(spec)
+				if err != nil {
+					return err
+				}
+				filters = append(filters, filter)
+			}
+
+			config, err := opts.lister.ImageSetConfig(catalog, filters)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintImageSetConfig(config, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to mirror the packages from")
+	cmd.Flags().StringSliceP("package", "p", nil, "The package to mirror as package[:channel[@min-max]], can be repeated")
+	cmd.Flags().StringP("output", "o", "yaml", "The output format (yaml, json)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+
+	return cmd
+}
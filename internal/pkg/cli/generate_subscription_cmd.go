@@ -0,0 +1,55 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewGenerateSubscriptionCmd creates a new generate subscription command.
+func NewGenerateSubscriptionCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscription",
+		Short: "Generate the manifests installing a package with OLM.",
+		Long: `Generate the Namespace, OperatorGroup and Subscription installing a package, ready to be applied.
+The channel defaults to the default channel of the package and the namespace to the one the package
+suggests. The OperatorGroup targets all namespaces when the channel head supports the AllNamespaces
+install mode and its own namespace otherwise. The openshift-operators namespace already targets all
+namespaces, so only the Subscription is generated for it. The source is the name of the OpenShift
+CatalogSource of the Red Hat catalogs, or the catalog repository name. --with-catalog-source also
+generates a CatalogSource serving the catalog, named after the catalog repository so that it does not
+replace the cluster catalog source.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			output, _ := cmd.Flags().GetString("output")
+			var subOpts list.SubscriptionOptions
+			subOpts.Package, _ = cmd.Flags().GetString("package")
+			subOpts.Channel, _ = cmd.Flags().GetString("channel")
+			subOpts.StartingCSV, _ = cmd.Flags().GetString("starting-csv")
+			subOpts.Namespace, _ = cmd.Flags().GetString("namespace")
+			subOpts.Source, _ = cmd.Flags().GetString("source")
+			subOpts.Approval, _ = cmd.Flags().GetString("approval")
+			subOpts.CatalogSource, _ = cmd.Flags().GetBool("with-catalog-source")
+
+			manifests, err := opts.lister.SubscriptionManifests(catalog, subOpts)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintManifests(manifests, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to install the package from")
+	cmd.Flags().StringP("package", "p", "", "The package to install")
+	cmd.Flags().String("channel", "", "The channel to subscribe to (defaults to the package default channel)")
+	cmd.Flags().String("starting-csv", "", "The bundle to install first, e.g. cluster-logging.v5.8.1")
+	cmd.Flags().StringP("namespace", "n", "", "The namespace to install the operator in (defaults to the namespace suggested by the package)")
+	cmd.Flags().String("source", "", "The name of the CatalogSource serving the catalog (defaults to one derived from the catalog)")
+	cmd.Flags().String("approval", "Automatic", "The install plan approval (Automatic, Manual)")
+	cmd.Flags().Bool("with-catalog-source", false, "Also generate the CatalogSource serving the catalog")
+	cmd.Flags().StringP("output", "o", "yaml", "The output format (yaml, json)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+
+	return cmd
+}
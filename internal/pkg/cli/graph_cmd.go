@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewGraphCmd creates a new graph command.
+func NewGraphCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "graph",
+		Short: "Render the upgrade graph of a package.",
+		Long: `Render the upgrade graph defined by the replaces, skips and skipRange fields of the channel entries
+of a package, for a single channel or all of them, as Graphviz DOT, Mermaid or a JSON adjacency list.
+Channel heads and the default channel are highlighted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			pkg, _ := cmd.Flags().GetString("package")
+			channel, _ := cmd.Flags().GetString("channel")
+			output, _ := cmd.Flags().GetString("output")
+			graph, err := opts.lister.UpgradeGraph(catalog, pkg, channel)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintGraph(graph, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to read the package from")
+	cmd.Flags().StringP("package", "p", "", "The package to render the upgrade graph for")
+	cmd.Flags().StringP("channel", "C", "", "The channel to render, all channels are rendered when empty")
+	cmd.Flags().StringP("output", "o", "dot", "The output format (dot, mermaid, json)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+
+	return cmd
+}
@@ -2,20 +2,68 @@
 
 package cli
 
-import "github.com/aguidirh/lumen/internal/pkg/list"
+import (
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
 
 // Lister defines the interface for all listing operations used by the CLI.
 type Lister interface {
-	Catalogs(version string) ([]string, error)
+	Catalogs(version string, sources []list.CatalogSource) ([]list.Catalog, error)
+	OCPVersions(sources []list.CatalogSource) (*list.OCPVersionMatrix, error)
 	PackagesByCatalog(catalogRef string) ([]list.Package, error)
 	ChannelsByPackage(catalogRef, pkgName string) ([]list.Channel, error)
 	BundleVersionsByChannel(catalogRef, pkgName, channelName string) ([]list.ChannelEntry, error)
+	PackageDetails(catalogRef, pkgName, channelName string) (*list.PackageDetails, error)
+	BundleDetails(catalogRef, pkgName, bundleName string) (*list.BundleDetails, error)
+	UpgradeGraph(catalogRef, pkgName, channelName string) (*list.UpgradeGraph, error)
+	UpgradePath(catalogRef, pkgName, channelName, from, to string) (*list.UpgradePath, error)
+	Search(query string, catalogRefs []string) ([]list.SearchResult, error)
+	RelatedImages(catalogRef string, filter list.RelatedImagesFilter) ([]list.RelatedImage, error)
+	Diff(fromRef, toRef string, packages []string) (*list.CatalogDiff, error)
+	Deprecations(catalogRef, pkgName string) ([]list.Deprecation, error)
+	FindAPI(catalogRefs []string, api, relation string) ([]list.APIMatch, error)
+	Where(pkgName, version string, sources []list.CatalogSource) (*list.PackageLocations, error)
+	Matrix(pkgName string, versions []string, sources []list.CatalogSource) (*list.PackageMatrix, error)
+	Dependencies(catalogRef, pkgName, bundleName string) (*list.DependencyTree, error)
+	Compatibility(catalogRef, targetOCP string, packages []string) (*list.CompatibilityReport, error)
+	Query(catalogRef, expr string) ([]any, error)
+	Filter(catalogRef string, filters []list.PackageFilter, outputDir string) (*list.FilteredCatalog, error)
+	ImageSetConfig(catalogRef string, filters []list.PackageFilter) (*list.ImageSetConfiguration, error)
+	SubscriptionManifests(catalogRef string, opts list.SubscriptionOptions) ([]list.Manifest, error)
 }
 
 // Printer defines the interface for printing operations used by the CLI.
 type Printer interface {
-	PrintCatalogs(ocpVersion string, catalogs []string)
-	PrintPackages(packages []list.Package)
+	PrintCatalogs(ocpVersion string, catalogs []list.Catalog)
+	PrintOCPVersions(matrix *list.OCPVersionMatrix, format string) error
+	PrintPackages(packages []list.Package, wide bool)
 	PrintChannels(channels []list.Channel)
-	PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry)
+	PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry, wide bool)
+	PrintCacheEntries(entries []catalog.CacheEntry)
+	PrintPackageDetails(details *list.PackageDetails, format string) error
+	PrintBundleDetails(details *list.BundleDetails, format string) error
+	PrintGraph(graph *list.UpgradeGraph, format string) error
+	PrintUpgradePath(path *list.UpgradePath, format string) error
+	PrintSearchResults(results []list.SearchResult, format string) error
+	PrintRelatedImages(images []list.RelatedImage, format, mirrorRegistry string) error
+	PrintDiff(diff *list.CatalogDiff, format string) error
+	PrintDeprecations(deprecations []list.Deprecation, format string) error
+	PrintAPIMatches(matches []list.APIMatch, format string) error
+	PrintPackageLocations(locations *list.PackageLocations, format string) error
+	PrintPackageMatrix(matrix *list.PackageMatrix, format string) error
+	PrintDependencies(tree *list.DependencyTree, format string) error
+	PrintCompatibility(report *list.CompatibilityReport, format string) error
+	PrintQueryResults(results []any, format string) error
+	PrintFilteredCatalog(filtered *list.FilteredCatalog)
+	PrintBuiltImage(built *catalog.BuiltImage)
+	PrintImageSetConfig(config *list.ImageSetConfiguration, format string) error
+	PrintManifests(manifests []list.Manifest, format string) error
+}
+
+// Cataloger defines the interface for catalog cache operations used by the CLI.
+type Cataloger interface {
+	ExportCache(catalogRefs []string, archivePath string) ([]catalog.CacheEntry, error)
+	ImportCache(archivePath string) ([]catalog.CacheEntry, error)
+	BuildImage(opts catalog.BuildOptions) (*catalog.BuiltImage, error)
 }
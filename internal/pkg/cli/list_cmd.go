@@ -13,8 +13,11 @@ func NewListCmd(opts *LumenOptions) *cobra.Command {
 	}
 
 	cmd.AddCommand(NewCatalogsCmd(opts))
+	cmd.AddCommand(NewOCPVersionsCmd(opts))
 	cmd.AddCommand(NewPackagesCmd(opts))
 	cmd.AddCommand(NewChannelsCmd(opts))
 	cmd.AddCommand(NewBundlesCmd(opts))
+	cmd.AddCommand(NewRelatedImagesCmd(opts))
+	cmd.AddCommand(NewDeprecationsCmd(opts))
 	return cmd
 }
@@ -1,30 +1,44 @@
 package cli
 
 import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
 	"github.com/aguidirh/lumen/internal/pkg/log"
 	"github.com/spf13/cobra"
 )
 
 // LumenOptions holds the options for the lumen command.
 type LumenOptions struct {
-	logLevel string
-	lister   Lister
-	printer  Printer
+	logLevel  string
+	lister    Lister
+	printer   Printer
+	cataloger Cataloger
+
+	configPath             string
+	catalogSourceSelectors []string
+	// catalogSources are the catalog sources selected for the command, nil means the Red Hat catalogs.
+	catalogSources []list.CatalogSource
 }
 
 // NewLumenOptions creates a new LumenOptions instance.
-func NewLumenOptions(lister Lister, printer Printer) *LumenOptions {
+func NewLumenOptions(lister Lister, printer Printer, cataloger Cataloger) *LumenOptions {
 	return &LumenOptions{
-		lister:  lister,
-		printer: printer,
+		lister:    lister,
+		printer:   printer,
+		cataloger: cataloger,
 	}
 }
 
 // NewLumenCmd creates a new lumen command.
-func NewLumenCmd(lister Lister, printer Printer) *cobra.Command {
+func NewLumenCmd(lister Lister, printer Printer, cataloger Cataloger) *cobra.Command {
 	opts := &LumenOptions{
-		lister:  lister,
-		printer: printer,
+		lister:    lister,
+		printer:   printer,
+		cataloger: cataloger,
 	}
 
 	cmd := &cobra.Command{
@@ -35,11 +49,81 @@ with a special focus on operator-framework File-Based Catalogs (FBC).
 It allows you to pull catalog images, inspect and list their contents, without needing a running Kubernetes cluster.`,
 		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
 			log.New(opts.logLevel)
-			return nil
+			return opts.resolveCatalogSources()
 		},
 	}
 
 	cmd.AddCommand(NewListCmd(opts))
+	cmd.AddCommand(NewShowCmd(opts))
+	cmd.AddCommand(NewGraphCmd(opts))
+	cmd.AddCommand(NewUpgradePathCmd(opts))
+	cmd.AddCommand(NewSearchCmd(opts))
+	cmd.AddCommand(NewDiffCmd(opts))
+	cmd.AddCommand(NewFindCmd(opts))
+	cmd.AddCommand(NewWhereCmd(opts))
+	cmd.AddCommand(NewMatrixCmd(opts))
+	cmd.AddCommand(NewDepsCmd(opts))
+	cmd.AddCommand(NewCompatCmd(opts))
+	cmd.AddCommand(NewQueryCmd(opts))
+	cmd.AddCommand(NewFilterCmd(opts))
+	cmd.AddCommand(NewBuildCmd(opts))
+	cmd.AddCommand(NewGenerateCmd(opts))
+	cmd.AddCommand(NewCacheCmd(opts))
 	cmd.PersistentFlags().StringVar(&opts.logLevel, "log-level", "info", "log level (debug, info, warn, error)")
+	cmd.PersistentFlags().StringVar(&opts.configPath, "config", "", "config file defining the catalog sources (default $XDG_CONFIG_HOME/lumen/config.yaml)")
+	cmd.PersistentFlags().StringSliceVar(&opts.catalogSourceSelectors, "catalog-source", nil, "catalog sources to use, by name or as repository[:tag-template], can be repeated")
 	return cmd
 }
+
+// resolveCatalogSources loads the catalog sources from the config file, falling back to
+// the Red Hat catalogs when there is none, and keeps the ones selected by --catalog-source.
+func (o *LumenOptions) resolveCatalogSources() error {
+	sources := list.DefaultCatalogSources()
+	path := o.configPath
+	if path == "" {
+		path = defaultConfigPath()
+	}
+	if path != "" {
+		loaded, err := list.LoadCatalogSources(path)
+		if err != nil {
+			return err
+		}
+		sources = loaded
+	}
+
+	selected, err := list.SelectCatalogSources(sources, o.catalogSourceSelectors)
+	if err != nil {
+		return err
+	}
+	o.catalogSources = selected
+	return nil
+}
+
+// catalogRefs returns the given catalog references, followed by the catalogs of the
+// selected catalog sources for ocpVersion when it is set.
+func (o *LumenOptions) catalogRefs(catalogs []string, ocpVersion string) ([]string, error) {
+	if ocpVersion == "" {
+		return catalogs, nil
+	}
+	versionCatalogs, err := o.lister.Catalogs(ocpVersion, o.catalogSources)
+	if err != nil {
+		return nil, err
+	}
+	for _, catalog := range versionCatalogs {
+		catalogs = append(catalogs, catalog.Reference)
+	}
+	return catalogs, nil
+}
+
+// defaultConfigPath returns the path of the user config file, or an empty string when it does not exist.
+func defaultConfigPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	path := filepath.Join(dir, "lumen", "config.yaml")
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return ""
+	}
+	return path
+}
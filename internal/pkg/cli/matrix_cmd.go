@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewMatrixCmd creates a new matrix command.
+func NewMatrixCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "matrix",
+		Short: "Show the channels of a package across OpenShift versions.",
+		Long: `Show the head of every channel of a package for several OpenShift versions side by side, e.g. to plan
+EUS upgrades. Versions are given as a range, 4.12-4.18, as a list, 4.12,4.14,4.16, or both. The package is read
+from the first catalog of the selected catalog sources that ships it, reusing the catalog cache.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			pkg, _ := cmd.Flags().GetString("package")
+			ocpVersions, _ := cmd.Flags().GetString("ocp-versions")
+			output, _ := cmd.Flags().GetString("output")
+			versions, err := list.ParseOCPVersions(ocpVersions)
+			if err != nil {
+				return err
+			}
+			matrix, err := opts.lister.Matrix(pkg, versions, opts.catalogSources)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintPackageMatrix(matrix, output)
+		},
+	}
+
+	cmd.Flags().StringP("package", "p", "", "The package name")
+	cmd.Flags().String("ocp-versions", "", "The OpenShift versions to compare, e.g. 4.12-4.18 or 4.12,4.14")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, csv, json, yaml)")
+	cmd.MarkFlagRequired("package")
+	cmd.MarkFlagRequired("ocp-versions")
+
+	return cmd
+}
@@ -12,6 +12,7 @@ package mock
 import (
 	reflect "reflect"
 
+	catalog "github.com/aguidirh/lumen/internal/pkg/catalog"
 	list "github.com/aguidirh/lumen/internal/pkg/list"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -40,6 +41,21 @@ func (m *MockLister) EXPECT() *MockListerMockRecorder {
 	return m.recorder
 }
 
+// BundleDetails mocks base method.
+func (m *MockLister) BundleDetails(catalogRef, pkgName, bundleName string) (*list.BundleDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BundleDetails", catalogRef, pkgName, bundleName)
+	ret0, _ := ret[0].(*list.BundleDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BundleDetails indicates an expected call of BundleDetails.
+func (mr *MockListerMockRecorder) BundleDetails(catalogRef, pkgName, bundleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BundleDetails", reflect.TypeOf((*MockLister)(nil).BundleDetails), catalogRef, pkgName, bundleName)
+}
+
 // BundleVersionsByChannel mocks base method.
 func (m *MockLister) BundleVersionsByChannel(catalogRef, pkgName, channelName string) ([]list.ChannelEntry, error) {
 	m.ctrl.T.Helper()
@@ -56,18 +72,18 @@ func (mr *MockListerMockRecorder) BundleVersionsByChannel(catalogRef, pkgName, c
 }
 
 // Catalogs mocks base method.
-func (m *MockLister) Catalogs(version string) ([]string, error) {
+func (m *MockLister) Catalogs(version string, sources []list.CatalogSource) ([]list.Catalog, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Catalogs", version)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "Catalogs", version, sources)
+	ret0, _ := ret[0].([]list.Catalog)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Catalogs indicates an expected call of Catalogs.
-func (mr *MockListerMockRecorder) Catalogs(version any) *gomock.Call {
+func (mr *MockListerMockRecorder) Catalogs(version, sources any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Catalogs", reflect.TypeOf((*MockLister)(nil).Catalogs), version)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Catalogs", reflect.TypeOf((*MockLister)(nil).Catalogs), version, sources)
 }
 
 // ChannelsByPackage mocks base method.
@@ -85,6 +101,156 @@ func (mr *MockListerMockRecorder) ChannelsByPackage(catalogRef, pkgName any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChannelsByPackage", reflect.TypeOf((*MockLister)(nil).ChannelsByPackage), catalogRef, pkgName)
 }
 
+// Compatibility mocks base method.
+func (m *MockLister) Compatibility(catalogRef, targetOCP string, packages []string) (*list.CompatibilityReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Compatibility", catalogRef, targetOCP, packages)
+	ret0, _ := ret[0].(*list.CompatibilityReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Compatibility indicates an expected call of Compatibility.
+func (mr *MockListerMockRecorder) Compatibility(catalogRef, targetOCP, packages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Compatibility", reflect.TypeOf((*MockLister)(nil).Compatibility), catalogRef, targetOCP, packages)
+}
+
+// Dependencies mocks base method.
+func (m *MockLister) Dependencies(catalogRef, pkgName, bundleName string) (*list.DependencyTree, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Dependencies", catalogRef, pkgName, bundleName)
+	ret0, _ := ret[0].(*list.DependencyTree)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Dependencies indicates an expected call of Dependencies.
+func (mr *MockListerMockRecorder) Dependencies(catalogRef, pkgName, bundleName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Dependencies", reflect.TypeOf((*MockLister)(nil).Dependencies), catalogRef, pkgName, bundleName)
+}
+
+// Deprecations mocks base method.
+func (m *MockLister) Deprecations(catalogRef, pkgName string) ([]list.Deprecation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Deprecations", catalogRef, pkgName)
+	ret0, _ := ret[0].([]list.Deprecation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Deprecations indicates an expected call of Deprecations.
+func (mr *MockListerMockRecorder) Deprecations(catalogRef, pkgName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Deprecations", reflect.TypeOf((*MockLister)(nil).Deprecations), catalogRef, pkgName)
+}
+
+// Diff mocks base method.
+func (m *MockLister) Diff(fromRef, toRef string, packages []string) (*list.CatalogDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Diff", fromRef, toRef, packages)
+	ret0, _ := ret[0].(*list.CatalogDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Diff indicates an expected call of Diff.
+func (mr *MockListerMockRecorder) Diff(fromRef, toRef, packages any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Diff", reflect.TypeOf((*MockLister)(nil).Diff), fromRef, toRef, packages)
+}
+
+// Filter mocks base method.
+func (m *MockLister) Filter(catalogRef string, filters []list.PackageFilter, outputDir string) (*list.FilteredCatalog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Filter", catalogRef, filters, outputDir)
+	ret0, _ := ret[0].(*list.FilteredCatalog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Filter indicates an expected call of Filter.
+func (mr *MockListerMockRecorder) Filter(catalogRef, filters, outputDir any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Filter", reflect.TypeOf((*MockLister)(nil).Filter), catalogRef, filters, outputDir)
+}
+
+// FindAPI mocks base method.
+func (m *MockLister) FindAPI(catalogRefs []string, api, relation string) ([]list.APIMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAPI", catalogRefs, api, relation)
+	ret0, _ := ret[0].([]list.APIMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindAPI indicates an expected call of FindAPI.
+func (mr *MockListerMockRecorder) FindAPI(catalogRefs, api, relation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAPI", reflect.TypeOf((*MockLister)(nil).FindAPI), catalogRefs, api, relation)
+}
+
+// ImageSetConfig mocks base method.
+func (m *MockLister) ImageSetConfig(catalogRef string, filters []list.PackageFilter) (*list.ImageSetConfiguration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImageSetConfig", catalogRef, filters)
+	ret0, _ := ret[0].(*list.ImageSetConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImageSetConfig indicates an expected call of ImageSetConfig.
+func (mr *MockListerMockRecorder) ImageSetConfig(catalogRef, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImageSetConfig", reflect.TypeOf((*MockLister)(nil).ImageSetConfig), catalogRef, filters)
+}
+
+// Matrix mocks base method.
+func (m *MockLister) Matrix(pkgName string, versions []string, sources []list.CatalogSource) (*list.PackageMatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Matrix", pkgName, versions, sources)
+	ret0, _ := ret[0].(*list.PackageMatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Matrix indicates an expected call of Matrix.
+func (mr *MockListerMockRecorder) Matrix(pkgName, versions, sources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Matrix", reflect.TypeOf((*MockLister)(nil).Matrix), pkgName, versions, sources)
+}
+
+// OCPVersions mocks base method.
+func (m *MockLister) OCPVersions(sources []list.CatalogSource) (*list.OCPVersionMatrix, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OCPVersions", sources)
+	ret0, _ := ret[0].(*list.OCPVersionMatrix)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OCPVersions indicates an expected call of OCPVersions.
+func (mr *MockListerMockRecorder) OCPVersions(sources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OCPVersions", reflect.TypeOf((*MockLister)(nil).OCPVersions), sources)
+}
+
+// PackageDetails mocks base method.
+func (m *MockLister) PackageDetails(catalogRef, pkgName, channelName string) (*list.PackageDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PackageDetails", catalogRef, pkgName, channelName)
+	ret0, _ := ret[0].(*list.PackageDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PackageDetails indicates an expected call of PackageDetails.
+func (mr *MockListerMockRecorder) PackageDetails(catalogRef, pkgName, channelName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackageDetails", reflect.TypeOf((*MockLister)(nil).PackageDetails), catalogRef, pkgName, channelName)
+}
+
 // PackagesByCatalog mocks base method.
 func (m *MockLister) PackagesByCatalog(catalogRef string) ([]list.Package, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +266,111 @@ func (mr *MockListerMockRecorder) PackagesByCatalog(catalogRef any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PackagesByCatalog", reflect.TypeOf((*MockLister)(nil).PackagesByCatalog), catalogRef)
 }
 
+// Query mocks base method.
+func (m *MockLister) Query(catalogRef, expr string) ([]any, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Query", catalogRef, expr)
+	ret0, _ := ret[0].([]any)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Query indicates an expected call of Query.
+func (mr *MockListerMockRecorder) Query(catalogRef, expr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Query", reflect.TypeOf((*MockLister)(nil).Query), catalogRef, expr)
+}
+
+// RelatedImages mocks base method.
+func (m *MockLister) RelatedImages(catalogRef string, filter list.RelatedImagesFilter) ([]list.RelatedImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelatedImages", catalogRef, filter)
+	ret0, _ := ret[0].([]list.RelatedImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelatedImages indicates an expected call of RelatedImages.
+func (mr *MockListerMockRecorder) RelatedImages(catalogRef, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelatedImages", reflect.TypeOf((*MockLister)(nil).RelatedImages), catalogRef, filter)
+}
+
+// Search mocks base method.
+func (m *MockLister) Search(query string, catalogRefs []string) ([]list.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", query, catalogRefs)
+	ret0, _ := ret[0].([]list.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockListerMockRecorder) Search(query, catalogRefs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockLister)(nil).Search), query, catalogRefs)
+}
+
+// SubscriptionManifests mocks base method.
+func (m *MockLister) SubscriptionManifests(catalogRef string, opts list.SubscriptionOptions) ([]list.Manifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscriptionManifests", catalogRef, opts)
+	ret0, _ := ret[0].([]list.Manifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscriptionManifests indicates an expected call of SubscriptionManifests.
+func (mr *MockListerMockRecorder) SubscriptionManifests(catalogRef, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscriptionManifests", reflect.TypeOf((*MockLister)(nil).SubscriptionManifests), catalogRef, opts)
+}
+
+// UpgradeGraph mocks base method.
+func (m *MockLister) UpgradeGraph(catalogRef, pkgName, channelName string) (*list.UpgradeGraph, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeGraph", catalogRef, pkgName, channelName)
+	ret0, _ := ret[0].(*list.UpgradeGraph)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeGraph indicates an expected call of UpgradeGraph.
+func (mr *MockListerMockRecorder) UpgradeGraph(catalogRef, pkgName, channelName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeGraph", reflect.TypeOf((*MockLister)(nil).UpgradeGraph), catalogRef, pkgName, channelName)
+}
+
+// UpgradePath mocks base method.
+func (m *MockLister) UpgradePath(catalogRef, pkgName, channelName, from, to string) (*list.UpgradePath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradePath", catalogRef, pkgName, channelName, from, to)
+	ret0, _ := ret[0].(*list.UpgradePath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradePath indicates an expected call of UpgradePath.
+func (mr *MockListerMockRecorder) UpgradePath(catalogRef, pkgName, channelName, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradePath", reflect.TypeOf((*MockLister)(nil).UpgradePath), catalogRef, pkgName, channelName, from, to)
+}
+
+// Where mocks base method.
+func (m *MockLister) Where(pkgName, version string, sources []list.CatalogSource) (*list.PackageLocations, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Where", pkgName, version, sources)
+	ret0, _ := ret[0].(*list.PackageLocations)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Where indicates an expected call of Where.
+func (mr *MockListerMockRecorder) Where(pkgName, version, sources any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Where", reflect.TypeOf((*MockLister)(nil).Where), pkgName, version, sources)
+}
+
 // MockPrinter is a mock of Printer interface.
 type MockPrinter struct {
 	ctrl     *gomock.Controller
@@ -124,20 +395,72 @@ func (m *MockPrinter) EXPECT() *MockPrinterMockRecorder {
 	return m.recorder
 }
 
+// PrintAPIMatches mocks base method.
+func (m *MockPrinter) PrintAPIMatches(matches []list.APIMatch, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintAPIMatches", matches, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintAPIMatches indicates an expected call of PrintAPIMatches.
+func (mr *MockPrinterMockRecorder) PrintAPIMatches(matches, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintAPIMatches", reflect.TypeOf((*MockPrinter)(nil).PrintAPIMatches), matches, format)
+}
+
+// PrintBuiltImage mocks base method.
+func (m *MockPrinter) PrintBuiltImage(built *catalog.BuiltImage) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrintBuiltImage", built)
+}
+
+// PrintBuiltImage indicates an expected call of PrintBuiltImage.
+func (mr *MockPrinterMockRecorder) PrintBuiltImage(built any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintBuiltImage", reflect.TypeOf((*MockPrinter)(nil).PrintBuiltImage), built)
+}
+
+// PrintBundleDetails mocks base method.
+func (m *MockPrinter) PrintBundleDetails(details *list.BundleDetails, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintBundleDetails", details, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintBundleDetails indicates an expected call of PrintBundleDetails.
+func (mr *MockPrinterMockRecorder) PrintBundleDetails(details, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintBundleDetails", reflect.TypeOf((*MockPrinter)(nil).PrintBundleDetails), details, format)
+}
+
 // PrintBundles mocks base method.
-func (m *MockPrinter) PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry) {
+func (m *MockPrinter) PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry, wide bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PrintBundles", pkgName, channelName, bundles)
+	m.ctrl.Call(m, "PrintBundles", pkgName, channelName, bundles, wide)
 }
 
 // PrintBundles indicates an expected call of PrintBundles.
-func (mr *MockPrinterMockRecorder) PrintBundles(pkgName, channelName, bundles any) *gomock.Call {
+func (mr *MockPrinterMockRecorder) PrintBundles(pkgName, channelName, bundles, wide any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintBundles", reflect.TypeOf((*MockPrinter)(nil).PrintBundles), pkgName, channelName, bundles)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintBundles", reflect.TypeOf((*MockPrinter)(nil).PrintBundles), pkgName, channelName, bundles, wide)
+}
+
+// PrintCacheEntries mocks base method.
+func (m *MockPrinter) PrintCacheEntries(entries []catalog.CacheEntry) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrintCacheEntries", entries)
+}
+
+// PrintCacheEntries indicates an expected call of PrintCacheEntries.
+func (mr *MockPrinterMockRecorder) PrintCacheEntries(entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintCacheEntries", reflect.TypeOf((*MockPrinter)(nil).PrintCacheEntries), entries)
 }
 
 // PrintCatalogs mocks base method.
-func (m *MockPrinter) PrintCatalogs(ocpVersion string, catalogs []string) {
+func (m *MockPrinter) PrintCatalogs(ocpVersion string, catalogs []list.Catalog) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "PrintCatalogs", ocpVersion, catalogs)
 }
@@ -160,14 +483,305 @@ func (mr *MockPrinterMockRecorder) PrintChannels(channels any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintChannels", reflect.TypeOf((*MockPrinter)(nil).PrintChannels), channels)
 }
 
+// PrintCompatibility mocks base method.
+func (m *MockPrinter) PrintCompatibility(report *list.CompatibilityReport, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintCompatibility", report, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintCompatibility indicates an expected call of PrintCompatibility.
+func (mr *MockPrinterMockRecorder) PrintCompatibility(report, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintCompatibility", reflect.TypeOf((*MockPrinter)(nil).PrintCompatibility), report, format)
+}
+
+// PrintDependencies mocks base method.
+func (m *MockPrinter) PrintDependencies(tree *list.DependencyTree, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintDependencies", tree, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintDependencies indicates an expected call of PrintDependencies.
+func (mr *MockPrinterMockRecorder) PrintDependencies(tree, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintDependencies", reflect.TypeOf((*MockPrinter)(nil).PrintDependencies), tree, format)
+}
+
+// PrintDeprecations mocks base method.
+func (m *MockPrinter) PrintDeprecations(deprecations []list.Deprecation, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintDeprecations", deprecations, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintDeprecations indicates an expected call of PrintDeprecations.
+func (mr *MockPrinterMockRecorder) PrintDeprecations(deprecations, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintDeprecations", reflect.TypeOf((*MockPrinter)(nil).PrintDeprecations), deprecations, format)
+}
+
+// PrintDiff mocks base method.
+func (m *MockPrinter) PrintDiff(diff *list.CatalogDiff, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintDiff", diff, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintDiff indicates an expected call of PrintDiff.
+func (mr *MockPrinterMockRecorder) PrintDiff(diff, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintDiff", reflect.TypeOf((*MockPrinter)(nil).PrintDiff), diff, format)
+}
+
+// PrintFilteredCatalog mocks base method.
+func (m *MockPrinter) PrintFilteredCatalog(filtered *list.FilteredCatalog) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "PrintFilteredCatalog", filtered)
+}
+
+// PrintFilteredCatalog indicates an expected call of PrintFilteredCatalog.
+func (mr *MockPrinterMockRecorder) PrintFilteredCatalog(filtered any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintFilteredCatalog", reflect.TypeOf((*MockPrinter)(nil).PrintFilteredCatalog), filtered)
+}
+
+// PrintGraph mocks base method.
+func (m *MockPrinter) PrintGraph(graph *list.UpgradeGraph, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintGraph", graph, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintGraph indicates an expected call of PrintGraph.
+func (mr *MockPrinterMockRecorder) PrintGraph(graph, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintGraph", reflect.TypeOf((*MockPrinter)(nil).PrintGraph), graph, format)
+}
+
+// PrintImageSetConfig mocks base method.
+func (m *MockPrinter) PrintImageSetConfig(config *list.ImageSetConfiguration, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintImageSetConfig", config, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintImageSetConfig indicates an expected call of PrintImageSetConfig.
+func (mr *MockPrinterMockRecorder) PrintImageSetConfig(config, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintImageSetConfig", reflect.TypeOf((*MockPrinter)(nil).PrintImageSetConfig), config, format)
+}
+
+// PrintManifests mocks base method.
+func (m *MockPrinter) PrintManifests(manifests []list.Manifest, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintManifests", manifests, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintManifests indicates an expected call of PrintManifests.
+func (mr *MockPrinterMockRecorder) PrintManifests(manifests, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintManifests", reflect.TypeOf((*MockPrinter)(nil).PrintManifests), manifests, format)
+}
+
+// PrintOCPVersions mocks base method.
+func (m *MockPrinter) PrintOCPVersions(matrix *list.OCPVersionMatrix, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintOCPVersions", matrix, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintOCPVersions indicates an expected call of PrintOCPVersions.
+func (mr *MockPrinterMockRecorder) PrintOCPVersions(matrix, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintOCPVersions", reflect.TypeOf((*MockPrinter)(nil).PrintOCPVersions), matrix, format)
+}
+
+// PrintPackageDetails mocks base method.
+func (m *MockPrinter) PrintPackageDetails(details *list.PackageDetails, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintPackageDetails", details, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintPackageDetails indicates an expected call of PrintPackageDetails.
+func (mr *MockPrinterMockRecorder) PrintPackageDetails(details, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintPackageDetails", reflect.TypeOf((*MockPrinter)(nil).PrintPackageDetails), details, format)
+}
+
+// PrintPackageLocations mocks base method.
+func (m *MockPrinter) PrintPackageLocations(locations *list.PackageLocations, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintPackageLocations", locations, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintPackageLocations indicates an expected call of PrintPackageLocations.
+func (mr *MockPrinterMockRecorder) PrintPackageLocations(locations, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintPackageLocations", reflect.TypeOf((*MockPrinter)(nil).PrintPackageLocations), locations, format)
+}
+
+// PrintPackageMatrix mocks base method.
+func (m *MockPrinter) PrintPackageMatrix(matrix *list.PackageMatrix, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintPackageMatrix", matrix, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintPackageMatrix indicates an expected call of PrintPackageMatrix.
+func (mr *MockPrinterMockRecorder) PrintPackageMatrix(matrix, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintPackageMatrix", reflect.TypeOf((*MockPrinter)(nil).PrintPackageMatrix), matrix, format)
+}
+
 // PrintPackages mocks base method.
-func (m *MockPrinter) PrintPackages(packages []list.Package) {
+func (m *MockPrinter) PrintPackages(packages []list.Package, wide bool) {
 	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "PrintPackages", packages)
+	m.ctrl.Call(m, "PrintPackages", packages, wide)
 }
 
 // PrintPackages indicates an expected call of PrintPackages.
-func (mr *MockPrinterMockRecorder) PrintPackages(packages any) *gomock.Call {
+func (mr *MockPrinterMockRecorder) PrintPackages(packages, wide any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintPackages", reflect.TypeOf((*MockPrinter)(nil).PrintPackages), packages, wide)
+}
+
+// PrintQueryResults mocks base method.
+func (m *MockPrinter) PrintQueryResults(results []any, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintQueryResults", results, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintQueryResults indicates an expected call of PrintQueryResults.
+func (mr *MockPrinterMockRecorder) PrintQueryResults(results, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintQueryResults", reflect.TypeOf((*MockPrinter)(nil).PrintQueryResults), results, format)
+}
+
+// PrintRelatedImages mocks base method.
+func (m *MockPrinter) PrintRelatedImages(images []list.RelatedImage, format, mirrorRegistry string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintRelatedImages", images, format, mirrorRegistry)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintRelatedImages indicates an expected call of PrintRelatedImages.
+func (mr *MockPrinterMockRecorder) PrintRelatedImages(images, format, mirrorRegistry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintRelatedImages", reflect.TypeOf((*MockPrinter)(nil).PrintRelatedImages), images, format, mirrorRegistry)
+}
+
+// PrintSearchResults mocks base method.
+func (m *MockPrinter) PrintSearchResults(results []list.SearchResult, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintSearchResults", results, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintSearchResults indicates an expected call of PrintSearchResults.
+func (mr *MockPrinterMockRecorder) PrintSearchResults(results, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintSearchResults", reflect.TypeOf((*MockPrinter)(nil).PrintSearchResults), results, format)
+}
+
+// PrintUpgradePath mocks base method.
+func (m *MockPrinter) PrintUpgradePath(path *list.UpgradePath, format string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrintUpgradePath", path, format)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PrintUpgradePath indicates an expected call of PrintUpgradePath.
+func (mr *MockPrinterMockRecorder) PrintUpgradePath(path, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintUpgradePath", reflect.TypeOf((*MockPrinter)(nil).PrintUpgradePath), path, format)
+}
+
+// MockCataloger is a mock of Cataloger interface.
+type MockCataloger struct {
+	ctrl     *gomock.Controller
+	recorder *MockCatalogerMockRecorder
+	isgomock struct{}
+}
+
+// MockCatalogerMockRecorder is the mock recorder for MockCataloger.
+type MockCatalogerMockRecorder struct {
+	mock *MockCataloger
+}
+
+// NewMockCataloger creates a new mock instance.
+func NewMockCataloger(ctrl *gomock.Controller) *MockCataloger {
+	mock := &MockCataloger{ctrl: ctrl}
+	mock.recorder = &MockCatalogerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCataloger) EXPECT() *MockCatalogerMockRecorder {
+	return m.recorder
+}
+
+// BuildImage mocks base method.
+func (m *MockCataloger) BuildImage(opts catalog.BuildOptions) (*catalog.BuiltImage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BuildImage", opts)
+	ret0, _ := ret[0].(*catalog.BuiltImage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BuildImage indicates an expected call of BuildImage.
+func (mr *MockCatalogerMockRecorder) BuildImage(opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BuildImage", reflect.TypeOf((*MockCataloger)(nil).BuildImage), opts)
+}
+
+// ExportCache mocks base method.
+func (m *MockCataloger) ExportCache(catalogRefs []string, archivePath string) ([]catalog.CacheEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportCache", catalogRefs, archivePath)
+	ret0, _ := ret[0].([]catalog.CacheEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportCache indicates an expected call of ExportCache.
+func (mr *MockCatalogerMockRecorder) ExportCache(catalogRefs, archivePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCache", reflect.TypeOf((*MockCataloger)(nil).ExportCache), catalogRefs, archivePath)
+}
+
+// ImportCache mocks base method.
+func (m *MockCataloger) ImportCache(archivePath string) ([]catalog.CacheEntry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportCache", archivePath)
+	ret0, _ := ret[0].([]catalog.CacheEntry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportCache indicates an expected call of ImportCache.
+func (mr *MockCatalogerMockRecorder) ImportCache(archivePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrintPackages", reflect.TypeOf((*MockPrinter)(nil).PrintPackages), packages)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportCache", reflect.TypeOf((*MockCataloger)(nil).ImportCache), archivePath)
 }
@@ -0,0 +1,28 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewOCPVersionsCmd creates a new ocp-versions command.
+func NewOCPVersionsCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ocp-versions",
+		Short: "List the OpenShift versions catalogs are published for.",
+		Long: `List the tags of every catalog source repository and show, for each OpenShift version,
+which catalogs are published. Use --catalog-source to restrict the sources.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			output, _ := cmd.Flags().GetString("output")
+
+			matrix, err := opts.lister.OCPVersions(opts.catalogSources)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintOCPVersions(matrix, output)
+		},
+	}
+
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	return cmd
+}
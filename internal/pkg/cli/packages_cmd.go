@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"slices"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
 	"github.com/spf13/cobra"
 )
 
@@ -13,17 +16,29 @@ func NewPackagesCmd(opts *LumenOptions) *cobra.Command {
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			catalog, _ := cmd.Flags().GetString("catalog")
+			hideDeprecated, _ := cmd.Flags().GetBool("hide-deprecated")
+			wide, _ := cmd.Flags().GetBool("wide")
+			arch, _ := cmd.Flags().GetString("arch")
 
 			packages, err := opts.lister.PackagesByCatalog(catalog)
 			if err != nil {
 				return err
 			}
+			if hideDeprecated {
+				packages = slices.DeleteFunc(packages, func(p list.Package) bool { return p.Deprecation != "" })
+			}
+			if arch != "" {
+				packages = slices.DeleteFunc(packages, func(p list.Package) bool { return !slices.Contains(p.Architectures, arch) })
+			}
 
-			opts.printer.PrintPackages(packages)
+			opts.printer.PrintPackages(packages, wide)
 			return nil
 		},
 	}
 	cmd.Flags().StringP("catalog", "c", "", "The catalog image to list packages from")
+	cmd.Flags().BoolP("wide", "w", false, "Show the architectures and operating systems supported by each package")
+	cmd.Flags().Bool("hide-deprecated", false, "Hide deprecated packages")
+	cmd.Flags().String("arch", "", "Only list packages supporting this architecture, e.g. arm64")
 	cmd.MarkFlagRequired("catalog")
 	return cmd
 }
@@ -0,0 +1,35 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewQueryCmd creates a new query command.
+func NewQueryCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query <expression>",
+		Short: "Query a catalog with a jq expression.",
+		Long: `Evaluate a jq expression over the packages, channels, bundles, deprecations and other blobs of a
+catalog and print each of its outputs. The expression receives an object with the packages, channels,
+bundles, deprecations and others keys, each holding the FBC blobs of that schema.
+For instance, the channel entries with a skipRange and no replaces are listed with:
+  [.channels[].entries[] | select(.skipRange and (.replaces | not))]
+Expressions are evaluated with gojq, which implements the full jq language.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			output, _ := cmd.Flags().GetString("output")
+			results, err := opts.lister.Query(catalog, args[0])
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintQueryResults(results, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to query")
+	cmd.Flags().StringP("output", "o", "json", "The output format (json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+
+	return cmd
+}
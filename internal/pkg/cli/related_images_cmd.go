@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/spf13/cobra"
+)
+
+// NewRelatedImagesCmd creates a new related-images command.
+func NewRelatedImagesCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "related-images",
+		Short: "List the images referenced by bundles.",
+		Long: `List the bundle images and related images referenced by the bundles of a catalog, deduplicated.
+Narrow the selection to a package, a channel and a version range to plan a mirror, and use
+-o mapping with --mirror-registry to write a mapping file for oc image mirror.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			output, _ := cmd.Flags().GetString("output")
+			mirrorRegistry, _ := cmd.Flags().GetString("mirror-registry")
+			var filter list.RelatedImagesFilter
+			filter.Package, _ = cmd.Flags().GetString("package")
+			filter.Channel, _ = cmd.Flags().GetString("channel")
+			filter.MinVersion, _ = cmd.Flags().GetString("min-version")
+			filter.MaxVersion, _ = cmd.Flags().GetString("max-version")
+
+			images, err := opts.lister.RelatedImages(catalog, filter)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintRelatedImages(images, output, mirrorRegistry)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to list related images from")
+	cmd.Flags().StringP("package", "p", "", "Only list images of this package")
+	cmd.Flags().StringP("channel", "C", "", "Only list images of bundles in this channel, requires --package")
+	cmd.Flags().String("min-version", "", "Only list images of bundles with this version or newer")
+	cmd.Flags().String("max-version", "", "Only list images of bundles with this version or older")
+	cmd.Flags().StringP("output", "o", "plain", "The output format (plain, json, yaml, mapping)")
+	cmd.Flags().String("mirror-registry", "", "The registry images are mirrored to, required by the mapping output")
+	cmd.MarkFlagRequired("catalog")
+	return cmd
+}
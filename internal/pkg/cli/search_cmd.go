@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// NewSearchCmd creates a new search command.
+func NewSearchCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search packages by keyword.",
+		Long: `Search packages by keyword across one or more catalogs. Package names, display names,
+descriptions, keywords, providers and categories are matched and the results are ranked by relevance.
+Use --ocp-version to search every catalog of an OpenShift version.`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalogs, _ := cmd.Flags().GetStringSlice("catalog")
+			ocpVersion, _ := cmd.Flags().GetString("ocp-version")
+			output, _ := cmd.Flags().GetString("output")
+			catalogs, err := opts.catalogRefs(catalogs, ocpVersion)
+			if err != nil {
+				return err
+			}
+			results, err := opts.lister.Search(strings.Join(args, " "), catalogs)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintSearchResults(results, output)
+		},
+	}
+
+	cmd.Flags().StringSliceP("catalog", "c", nil, "The catalog images to search, can be repeated")
+	cmd.Flags().StringP("ocp-version", "v", "", "Search every catalog of this OpenShift version")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagsOneRequired("catalog", "ocp-version")
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewShowBundleCmd creates a new show bundle command.
+func NewShowBundleCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bundle <bundle-name>",
+		Short: "Show the metadata of a bundle.",
+		Long: `Show the properties, provided and required APIs, related images, CSV metadata and
+minimum Kubernetes version of a bundle, read from the catalog without pulling the bundle image.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			pkg, _ := cmd.Flags().GetString("package")
+			output, _ := cmd.Flags().GetString("output")
+			details, err := opts.lister.BundleDetails(catalog, pkg, args[0])
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintBundleDetails(details, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to read the bundle from")
+	cmd.Flags().StringP("package", "p", "", "The package the bundle belongs to")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+
+	return cmd
+}
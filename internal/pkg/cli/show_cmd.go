@@ -0,0 +1,19 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewShowCmd creates a new show command.
+func NewShowCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the details of a resource from an operator catalog.",
+		Long:  "Show the full metadata of a single resource from an operator catalog, such as a package or a bundle.",
+	}
+
+	cmd.AddCommand(NewShowPackageCmd(opts))
+	cmd.AddCommand(NewShowBundleCmd(opts))
+
+	return cmd
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewShowPackageCmd creates a new show package command.
+func NewShowPackageCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "package <package-name>",
+		Short: "Show the installation metadata of a package.",
+		Long: `Show the supported install modes, suggested namespace and capability level of a package, read from the
+CSV metadata of the head of its default channel, or of --channel, without pulling the bundle image.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			channel, _ := cmd.Flags().GetString("channel")
+			output, _ := cmd.Flags().GetString("output")
+			details, err := opts.lister.PackageDetails(catalog, args[0], channel)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintPackageDetails(details, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to read the package from")
+	cmd.Flags().StringP("channel", "C", "", "The channel whose head is read, the default channel when not set")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+
+	return cmd
+}
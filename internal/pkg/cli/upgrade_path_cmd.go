@@ -0,0 +1,42 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewUpgradePathCmd creates a new upgrade-path command.
+func NewUpgradePathCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upgrade-path",
+		Short: "Compute the upgrade path between two bundles of a channel.",
+		Long: `Compute the shortest sequence of upgrades OLM takes from an installed bundle to a target bundle,
+following the replaces, skips and skipRange edges of a channel. The channel head is the target when --to is not set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog, _ := cmd.Flags().GetString("catalog")
+			pkg, _ := cmd.Flags().GetString("package")
+			channel, _ := cmd.Flags().GetString("channel")
+			from, _ := cmd.Flags().GetString("from")
+			to, _ := cmd.Flags().GetString("to")
+			output, _ := cmd.Flags().GetString("output")
+			path, err := opts.lister.UpgradePath(catalog, pkg, channel, from, to)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintUpgradePath(path, output)
+		},
+	}
+
+	cmd.Flags().StringP("catalog", "c", "", "The catalog image to read the package from")
+	cmd.Flags().StringP("package", "p", "", "The package to compute the upgrade path for")
+	cmd.Flags().StringP("channel", "C", "", "The channel to follow")
+	cmd.Flags().String("from", "", "The installed bundle (CSV name) to upgrade from")
+	cmd.Flags().String("to", "", "The bundle (CSV name) to upgrade to, defaults to the channel head")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("catalog")
+	cmd.MarkFlagRequired("package")
+	cmd.MarkFlagRequired("channel")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+)
+
+// NewWhereCmd creates a new where command.
+func NewWhereCmd(opts *LumenOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "where <package>",
+		Short: "Find the catalogs containing a package.",
+		Long: `Find which catalogs of an OpenShift version contain a package, with its default channel and head in
+each of them. Packages shipped by several catalogs are flagged as duplicates.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ocpVersion, _ := cmd.Flags().GetString("ocp-version")
+			output, _ := cmd.Flags().GetString("output")
+			locations, err := opts.lister.Where(args[0], ocpVersion, opts.catalogSources)
+			if err != nil {
+				return err
+			}
+			return opts.printer.PrintPackageLocations(locations, output)
+		},
+	}
+
+	cmd.Flags().StringP("ocp-version", "v", "", "The OpenShift version whose catalogs are searched")
+	cmd.Flags().StringP("output", "o", "table", "The output format (table, json, yaml)")
+	cmd.MarkFlagRequired("ocp-version")
+
+	return cmd
+}
@@ -5,11 +5,13 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"fmt"
 	"io"
 	"io/fs"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 // FsIO provides methods for file system operations.
@@ -96,6 +98,10 @@ func (f *FsIO) UntarFromStream(r io.Reader, dest string) error {
 		}
 
 		target := filepath.Join(dest, header.Name)
+		// Refuse entries that would be written outside of the destination directory.
+		if target != filepath.Clean(dest) && !strings.HasPrefix(target, filepath.Clean(dest)+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid tar entry %q: path escapes destination", header.Name)
+		}
 
 		switch header.Typeflag {
 		case tar.TypeDir:
@@ -106,6 +112,10 @@ func (f *FsIO) UntarFromStream(r io.Reader, dest string) error {
 				}
 			}
 		case tar.TypeReg:
+			// Make sure the parent directory exists, some archives omit directory entries.
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
 			// Create the file.
 			f, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR, os.FileMode(header.Mode))
 			if err != nil {
@@ -120,3 +130,52 @@ func (f *FsIO) UntarFromStream(r io.Reader, dest string) error {
 		}
 	}
 }
+
+// TarDirectory writes the contents of the src directory as an uncompressed tar stream to w.
+// Entry names are relative to src.
+func (f *FsIO) TarDirectory(src string, w io.Writer) error {
+	tw := tar.NewWriter(w)
+	err := filepath.Walk(src, func(path string, info fs.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		relPath, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+		if relPath == "." {
+			return nil
+		}
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			// Only directories and regular files are needed to rebuild the tree.
+			return nil
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(relPath)
+		if info.IsDir() {
+			header.Name += "/"
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+
+		in, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer in.Close()
+		_, err = io.Copy(tw, in)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	return tw.Close()
+}
@@ -143,3 +143,59 @@ func TestFsIO_CopyFile_NonExistentSource(t *testing.T) {
 	err := f.CopyFile(srcFile, dstFile)
 	require.Error(t, err)
 }
+
+func TestFsIO_UntarFromStream_PathTraversal(t *testing.T) {
+	f := fsio.NewFsIO()
+	destDir := t.TempDir()
+
+	var buf bytes.Buffer
+	tw := tar.NewWriter(&buf)
+	err := tw.WriteHeader(&tar.Header{Name: "../escape.txt", Typeflag: tar.TypeReg, Size: 4, Mode: 0644})
+	require.NoError(t, err)
+	_, err = tw.Write([]byte("evil"))
+	require.NoError(t, err)
+	err = tw.Close()
+	require.NoError(t, err)
+
+	err = f.UntarFromStream(bytes.NewReader(buf.Bytes()), destDir)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "path escapes destination")
+	assert.NoFileExists(t, filepath.Join(filepath.Dir(destDir), "escape.txt"))
+}
+
+func TestFsIO_TarDirectory(t *testing.T) {
+	f := fsio.NewFsIO()
+	srcDir := t.TempDir()
+	dstDir := t.TempDir()
+
+	err := os.MkdirAll(filepath.Join(srcDir, "subdir"), 0755)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(srcDir, "file1.txt"), []byte("file1"), 0644)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(srcDir, "subdir", "file2.txt"), []byte("file2"), 0644)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	err = f.TarDirectory(srcDir, &buf)
+	require.NoError(t, err)
+
+	// The archive must round-trip through UntarFromStream.
+	err = f.UntarFromStream(bytes.NewReader(buf.Bytes()), dstDir)
+	require.NoError(t, err)
+
+	content, err := os.ReadFile(filepath.Join(dstDir, "file1.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("file1"), content)
+
+	content, err = os.ReadFile(filepath.Join(dstDir, "subdir", "file2.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("file2"), content)
+}
+
+func TestFsIO_TarDirectory_NonExistentSource(t *testing.T) {
+	f := fsio.NewFsIO()
+	var buf bytes.Buffer
+
+	err := f.TarDirectory(filepath.Join(t.TempDir(), "nonexistent"), &buf)
+	require.Error(t, err)
+}
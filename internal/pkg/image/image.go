@@ -6,9 +6,11 @@ import (
 	"fmt"
 
 	"github.com/containers/image/v5/copy"
+	"github.com/containers/image/v5/docker"
 	"github.com/containers/image/v5/docker/reference"
 	"github.com/containers/image/v5/signature"
 	"github.com/containers/image/v5/transports/alltransports"
+	"github.com/containers/image/v5/types"
 	"github.com/opencontainers/go-digest"
 )
 
@@ -69,6 +71,39 @@ func (i *Imager) CopyToOci(imageRef, ociDir string) (string, error) {
 	return d.String(), nil
 }
 
+// CopyImage copies an image between two transport qualified references, such as docker://,
+// oci: or oci-archive:, and returns the digest of the manifest written. When arch is set, the
+// image of that architecture is selected from multi-architecture sources.
+func (i *Imager) CopyImage(srcRef, destRef, arch string) (string, error) {
+	i.log.Debugf("Copying image %s to %s...", srcRef, destRef)
+	src, err := alltransports.ParseImageName(srcRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse source image name: %w", err)
+	}
+	dest, err := alltransports.ParseImageName(destRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse destination image name: %w", err)
+	}
+
+	policyCtx, err := i.PolicyContext()
+	if err != nil {
+		return "", err
+	}
+	defer policyCtx.Destroy()
+
+	manifestBytes, err := copy.Image(context.Background(), policyCtx, dest, src, &copy.Options{
+		RemoveSignatures: true,
+		SourceCtx:        &types.SystemContext{ArchitectureChoice: arch},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to copy image %s to %s: %w", srcRef, destRef, err)
+	}
+
+	d := digest.FromBytes(manifestBytes)
+	i.log.Debugf("Successfully copied image. Digest: %s", d.String())
+	return d.String(), nil
+}
+
 // RemoteInfo retrieves the name, tag, and digest of a remote image.
 func (i *Imager) RemoteInfo(imageRef string) (string, string, digest.Digest, error) {
 	i.log.Debugf("Retrieving remote information for %s...", imageRef)
@@ -111,3 +146,20 @@ func (i *Imager) RemoteInfo(imageRef string) (string, string, digest.Digest, err
 	i.log.Debugf("Successfully retrieved remote information for %s", imageRef)
 	return repoName, tag, d, nil
 }
+
+// RepositoryTags lists the tags of a repository in a Docker registry.
+func (i *Imager) RepositoryTags(repository string) ([]string, error) {
+	i.log.Debugf("Listing tags of repository %s...", repository)
+	srcRef, err := alltransports.ParseImageName("docker://" + repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse repository name: %w", err)
+	}
+
+	tags, err := docker.GetRepositoryTags(context.Background(), nil, srcRef)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags of %s: %w", repository, err)
+	}
+
+	i.log.Debugf("Found %d tags in repository %s", len(tags), repository)
+	return tags, nil
+}
@@ -2,6 +2,8 @@
 package image_test
 
 import (
+	"bytes"
+	"encoding/json"
 	"os"
 	"path/filepath"
 	"testing"
@@ -9,6 +11,8 @@ import (
 	"github.com/aguidirh/lumen/internal/pkg/image"
 	mock_image "github.com/aguidirh/lumen/internal/pkg/image/mock"
 	"github.com/opencontainers/go-digest"
+	"github.com/opencontainers/image-spec/specs-go"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -86,3 +90,70 @@ func TestImager_RemoteInfo(t *testing.T) {
 	_, err = digest.Parse(d.String())
 	assert.NoError(t, err, "should be a valid digest")
 }
+
+func TestImager_AppendLayer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockLogger := mock_image.NewMockLogger(ctrl)
+	mockLogger.EXPECT().Debugf(gomock.Any(), gomock.Any()).AnyTimes()
+	mockLogger.EXPECT().Debugf(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	imager := image.NewImager(mockLogger)
+
+	// A base image with no layers and an entrypoint.
+	ociDir := t.TempDir()
+	writeBlob := func(data []byte) ociv1.Descriptor {
+		d := digest.FromBytes(data)
+		require.NoError(t, os.MkdirAll(filepath.Join(ociDir, "blobs", "sha256"), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(ociDir, "blobs", "sha256", d.Encoded()), data, 0644))
+		return ociv1.Descriptor{Digest: d, Size: int64(len(data))}
+	}
+	marshal := func(v any) []byte {
+		data, err := json.Marshal(v)
+		require.NoError(t, err)
+		return data
+	}
+	config := writeBlob(marshal(ociv1.Image{
+		Platform: ociv1.Platform{Architecture: "amd64", OS: "linux"},
+		Config:   ociv1.ImageConfig{Entrypoint: []string{"/bin/sh"}, Labels: map[string]string{"base": "true"}},
+		RootFS:   ociv1.RootFS{Type: "layers"},
+	}))
+	config.MediaType = ociv1.MediaTypeImageConfig
+	manifest := writeBlob(marshal(ociv1.Manifest{Versioned: specs.Versioned{SchemaVersion: 2}, MediaType: ociv1.MediaTypeImageManifest, Config: config}))
+	manifest.MediaType = ociv1.MediaTypeImageManifest
+	manifest.Annotations = map[string]string{ociv1.AnnotationRefName: "latest"}
+	require.NoError(t, os.WriteFile(filepath.Join(ociDir, "index.json"), marshal(ociv1.Index{Versioned: specs.Versioned{SchemaVersion: 2}, Manifests: []ociv1.Descriptor{manifest}}), 0644))
+
+	layer := []byte("uncompressed tar content")
+	d, err := imager.AppendLayer(ociDir, bytes.NewReader(layer), ociv1.ImageConfig{
+		Labels: map[string]string{"operators.operatorframework.io.index.configs.v1": "/configs"},
+		Cmd:    []string{"serve", "/configs"},
+	})
+	require.NoError(t, err)
+
+	readBlob := func(d digest.Digest, v any) {
+		data, err := os.ReadFile(filepath.Join(ociDir, "blobs", "sha256", d.Encoded()))
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(data, v))
+	}
+	var index ociv1.Index
+	data, err := os.ReadFile(filepath.Join(ociDir, "index.json"))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &index))
+	require.Len(t, index.Manifests, 1)
+	assert.Equal(t, d, index.Manifests[0].Digest.String())
+	assert.Equal(t, "latest", index.Manifests[0].Annotations[ociv1.AnnotationRefName])
+
+	var updated ociv1.Manifest
+	readBlob(index.Manifests[0].Digest, &updated)
+	require.Len(t, updated.Layers, 1)
+	assert.Equal(t, ociv1.MediaTypeImageLayerGzip, updated.Layers[0].MediaType)
+
+	var updatedConfig ociv1.Image
+	readBlob(updated.Config.Digest, &updatedConfig)
+	assert.Equal(t, []digest.Digest{digest.FromBytes(layer)}, updatedConfig.RootFS.DiffIDs)
+	assert.Equal(t, map[string]string{"base": "true", "operators.operatorframework.io.index.configs.v1": "/configs"}, updatedConfig.Config.Labels)
+	assert.Equal(t, []string{"/bin/sh"}, updatedConfig.Config.Entrypoint)
+	assert.Equal(t, []string{"serve", "/configs"}, updatedConfig.Config.Cmd)
+	assert.Len(t, updatedConfig.History, 1)
+}
@@ -0,0 +1,132 @@
+package image
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/opencontainers/go-digest"
+	ociv1 "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// AppendLayer appends an uncompressed tar layer to the single image of the OCI layout at ociDir.
+// The layer is stored gzip compressed. The labels of config are added to the image labels, and
+// its entrypoint and command replace the image ones when set. It returns the digest of the new
+// manifest, which replaces the previous one in the layout index.
+func (i *Imager) AppendLayer(ociDir string, layer io.Reader, config ociv1.ImageConfig) (string, error) {
+	i.log.Debugf("Appending layer to OCI layout at %s...", ociDir)
+	var index ociv1.Index
+	if err := readJSON(filepath.Join(ociDir, ociv1.ImageIndexFile), &index); err != nil {
+		return "", fmt.Errorf("failed to read OCI layout index: %w", err)
+	}
+	if len(index.Manifests) != 1 {
+		return "", fmt.Errorf("expected a single image in OCI layout %s, found %d", ociDir, len(index.Manifests))
+	}
+	if mediaType := index.Manifests[0].MediaType; mediaType != ociv1.MediaTypeImageManifest {
+		return "", fmt.Errorf("unsupported manifest media type %q in OCI layout %s", mediaType, ociDir)
+	}
+
+	var manifest ociv1.Manifest
+	if err := readJSON(blobPath(ociDir, index.Manifests[0].Digest), &manifest); err != nil {
+		return "", fmt.Errorf("failed to read image manifest: %w", err)
+	}
+	var image ociv1.Image
+	if err := readJSON(blobPath(ociDir, manifest.Config.Digest), &image); err != nil {
+		return "", fmt.Errorf("failed to read image config: %w", err)
+	}
+
+	// The diff ID of a layer is the digest of its uncompressed content.
+	diffID := digest.Canonical.Digester()
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := io.Copy(gz, io.TeeReader(layer, diffID.Hash())); err != nil {
+		return "", fmt.Errorf("failed to compress layer: %w", err)
+	}
+	if err := gz.Close(); err != nil {
+		return "", fmt.Errorf("failed to compress layer: %w", err)
+	}
+	layerDesc, err := writeBlob(ociDir, ociv1.MediaTypeImageLayerGzip, compressed.Bytes())
+	if err != nil {
+		return "", err
+	}
+
+	now := time.Now().UTC()
+	image.Created = &now
+	image.RootFS.DiffIDs = append(image.RootFS.DiffIDs, diffID.Digest())
+	image.History = append(image.History, ociv1.History{Created: &now, CreatedBy: "lumen build"})
+	if len(config.Labels) > 0 && image.Config.Labels == nil {
+		image.Config.Labels = make(map[string]string, len(config.Labels))
+	}
+	for k, v := range config.Labels {
+		image.Config.Labels[k] = v
+	}
+	if len(config.Entrypoint) > 0 {
+		image.Config.Entrypoint = config.Entrypoint
+	}
+	if len(config.Cmd) > 0 {
+		image.Config.Cmd = config.Cmd
+	}
+	configBytes, err := json.Marshal(image)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize image config: %w", err)
+	}
+	if manifest.Config, err = writeBlob(ociDir, ociv1.MediaTypeImageConfig, configBytes); err != nil {
+		return "", err
+	}
+
+	manifest.Layers = append(manifest.Layers, layerDesc)
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize image manifest: %w", err)
+	}
+	manifestDesc, err := writeBlob(ociDir, ociv1.MediaTypeImageManifest, manifestBytes)
+	if err != nil {
+		return "", err
+	}
+	// Keep the annotations of the previous manifest, such as its reference name.
+	manifestDesc.Annotations = index.Manifests[0].Annotations
+	manifestDesc.Platform = index.Manifests[0].Platform
+	index.Manifests[0] = manifestDesc
+
+	indexBytes, err := json.Marshal(index)
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize OCI layout index: %w", err)
+	}
+	if err := os.WriteFile(filepath.Join(ociDir, ociv1.ImageIndexFile), indexBytes, 0644); err != nil {
+		return "", fmt.Errorf("failed to write OCI layout index: %w", err)
+	}
+
+	i.log.Debugf("Successfully appended layer %s. Digest: %s", layerDesc.Digest, manifestDesc.Digest)
+	return manifestDesc.Digest.String(), nil
+}
+
+// blobPath returns the path of a blob in an OCI layout.
+func blobPath(ociDir string, d digest.Digest) string {
+	return filepath.Join(ociDir, ociv1.ImageBlobsDir, d.Algorithm().String(), d.Encoded())
+}
+
+// writeBlob stores data as a blob of an OCI layout and returns its descriptor.
+func writeBlob(ociDir, mediaType string, data []byte) (ociv1.Descriptor, error) {
+	desc := ociv1.Descriptor{MediaType: mediaType, Digest: digest.FromBytes(data), Size: int64(len(data))}
+	path := blobPath(ociDir, desc.Digest)
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return ociv1.Descriptor{}, fmt.Errorf("failed to create blobs directory: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return ociv1.Descriptor{}, fmt.Errorf("failed to write blob %s: %w", desc.Digest, err)
+	}
+	return desc, nil
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
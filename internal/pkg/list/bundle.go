@@ -0,0 +1,202 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"sigs.k8s.io/yaml"
+)
+
+// bundlesByName indexes the bundles of a package by bundle name.
+func bundlesByName(cfg *declcfg.DeclarativeConfig, pkgName string) map[string]*declcfg.Bundle {
+	bundles := make(map[string]*declcfg.Bundle)
+	for i := range cfg.Bundles {
+		if cfg.Bundles[i].Package == pkgName {
+			bundles[cfg.Bundles[i].Name] = &cfg.Bundles[i]
+		}
+	}
+	return bundles
+}
+
+// bundlesByPackage indexes all the bundles of a catalog by package and bundle name, so that loops
+// over packages or channels look bundles up without scanning the catalog each time.
+func bundlesByPackage(cfg *declcfg.DeclarativeConfig) map[string]map[string]*declcfg.Bundle {
+	index := make(map[string]map[string]*declcfg.Bundle)
+	for i := range cfg.Bundles {
+		b := &cfg.Bundles[i]
+		if index[b.Package] == nil {
+			index[b.Package] = make(map[string]*declcfg.Bundle)
+		}
+		index[b.Package][b.Name] = b
+	}
+	return index
+}
+
+// bundleVersion returns the version declared by the olm.package property of a bundle,
+// or an empty string when the bundle has none.
+func bundleVersion(b *declcfg.Bundle) string {
+	if b == nil {
+		return ""
+	}
+	for _, prop := range b.Properties {
+		if prop.Type != property.TypePackage {
+			continue
+		}
+		var pkg property.Package
+		if err := json.Unmarshal(prop.Value, &pkg); err == nil {
+			return pkg.Version
+		}
+	}
+	return ""
+}
+
+// parseVersion parses a bundle version, tolerating a leading "v" and missing
+// minor or patch components.
+func parseVersion(v string) (semver.Version, bool) {
+	if v == "" {
+		return semver.Version{}, false
+	}
+	version, err := semver.ParseTolerant(v)
+	if err != nil {
+		return semver.Version{}, false
+	}
+	return version, true
+}
+
+// csvMetadata returns the olm.csv.metadata property of a bundle, or nil when the bundle has none.
+// Bundles of older catalogs only carry their manifests as olm.bundle.object properties, so the
+// metadata is then read from the ClusterServiceVersion among them.
+func csvMetadata(b *declcfg.Bundle) *property.CSVMetadata {
+	if b == nil {
+		return nil
+	}
+	for _, prop := range b.Properties {
+		if prop.Type != property.TypeCSVMetadata {
+			continue
+		}
+		var metadata property.CSVMetadata
+		if err := json.Unmarshal(prop.Value, &metadata); err == nil {
+			return &metadata
+		}
+	}
+	for _, prop := range b.Properties {
+		if prop.Type != property.TypeBundleObject {
+			continue
+		}
+		if metadata := bundleObjectCSVMetadata(prop.Value); metadata != nil {
+			return metadata
+		}
+	}
+	return nil
+}
+
+// bundleObjectCSVMetadata returns the CSV metadata of an olm.bundle.object property value, or nil
+// when the object is not a ClusterServiceVersion.
+func bundleObjectCSVMetadata(value json.RawMessage) *property.CSVMetadata {
+	var object property.BundleObject
+	if err := json.Unmarshal(value, &object); err != nil {
+		return nil
+	}
+	var csv v1alpha1.ClusterServiceVersion
+	if err := yaml.Unmarshal(object.Data, &csv); err != nil || csv.Kind != v1alpha1.ClusterServiceVersionKind {
+		return nil
+	}
+	var metadata property.CSVMetadata
+	if err := json.Unmarshal(property.MustBuildCSVMetadata(csv).Value, &metadata); err != nil {
+		return nil
+	}
+	return &metadata
+}
+
+// defaultChannelHead returns the bundle at the head of the default channel of a package,
+// or nil when it cannot be determined.
+func defaultChannelHead(cfg *declcfg.DeclarativeConfig, pkg declcfg.Package, bundles map[string]*declcfg.Bundle) *declcfg.Bundle {
+	for _, ch := range cfg.Channels {
+		if ch.Package != pkg.Name || ch.Name != pkg.DefaultChannel {
+			continue
+		}
+		heads, _ := ChannelHeads(ch)
+		if len(heads) == 0 {
+			return nil
+		}
+		return bundles[heads[0]]
+	}
+	return nil
+}
+
+// versionFilter returns a predicate matching bundle versions between minVersion and maxVersion,
+// both inclusive. An empty bound leaves that side of the range open. When a bound is set,
+// bundles without a valid version never match.
+func versionFilter(minVersion, maxVersion string) (func(version string) bool, error) {
+	if minVersion == "" && maxVersion == "" {
+		return func(string) bool { return true }, nil
+	}
+	var lower, upper *semver.Version
+	if minVersion != "" {
+		v, ok := parseVersion(minVersion)
+		if !ok {
+			return nil, fmt.Errorf("invalid minimum version %q", minVersion)
+		}
+		lower = &v
+	}
+	if maxVersion != "" {
+		v, ok := parseVersion(maxVersion)
+		if !ok {
+			return nil, fmt.Errorf("invalid maximum version %q", maxVersion)
+		}
+		upper = &v
+	}
+	if lower != nil && upper != nil && lower.GT(*upper) {
+		return nil, fmt.Errorf("minimum version %s is greater than maximum version %s", minVersion, maxVersion)
+	}
+	return func(version string) bool {
+		v, ok := parseVersion(version)
+		if !ok {
+			return false
+		}
+		return (lower == nil || v.GTE(*lower)) && (upper == nil || v.LTE(*upper))
+	}, nil
+}
+
+// entryVersion returns the version of a channel entry. Entries without an olm.package
+// version fall back to the version embedded in the bundle name, e.g. 1.2.3 for pkg.v1.2.3.
+// The last ".v" of the name is used, so that package names containing ".v" are skipped.
+func entryVersion(entry ChannelEntry) (semver.Version, bool) {
+	if v, ok := parseVersion(entry.Version); ok {
+		return v, true
+	}
+	if i := strings.LastIndex(entry.Name, ".v"); i >= 0 {
+		if v, ok := parseVersion(entry.Name[i+len(".v"):]); ok {
+			return v, true
+		}
+	}
+	if i := strings.Index(entry.Name, "."); i >= 0 {
+		if v, ok := parseVersion(entry.Name[i+len("."):]); ok {
+			return v, true
+		}
+	}
+	return semver.Version{}, false
+}
+
+// sortChannelEntries sorts channel entries by semantic version, oldest first. Entries
+// without a version are sorted by name after the versioned ones.
+func sortChannelEntries(entries []ChannelEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		vi, iok := entryVersion(entries[i])
+		vj, jok := entryVersion(entries[j])
+		switch {
+		case iok && jok && !vi.EQ(vj):
+			return vi.LT(vj)
+		case iok != jok:
+			return iok
+		default:
+			return entries[i].Name < entries[j].Name
+		}
+	})
+}
@@ -0,0 +1,82 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// BundleDetails holds the metadata of a single bundle as declared in the catalog.
+type BundleDetails struct {
+	Name             string                     `json:"name"`
+	Package          string                     `json:"package"`
+	Version          string                     `json:"version,omitempty"`
+	Image            string                     `json:"image"`
+	Channels         []string                   `json:"channels,omitempty"`
+	MinKubeVersion   string                     `json:"minKubeVersion,omitempty"`
+	CSVMetadata      *property.CSVMetadata      `json:"csvMetadata,omitempty"`
+	ProvidedGVKs     []property.GVK             `json:"providedGVKs,omitempty"`
+	RequiredGVKs     []property.GVKRequired     `json:"requiredGVKs,omitempty"`
+	RequiredPackages []property.PackageRequired `json:"requiredPackages,omitempty"`
+	RelatedImages    []declcfg.RelatedImage     `json:"relatedImages,omitempty"`
+	// Properties holds every property of the bundle except olm.bundle.object,
+	// which embeds the full bundle manifests.
+	Properties []property.Property `json:"properties,omitempty"`
+}
+
+// BundleDetails returns the metadata of a bundle of a package, read from the
+// catalog without pulling the bundle image.
+func (c *CatalogLister) BundleDetails(catalogRef, pkgName, bundleName string) (*BundleDetails, error) {
+	if catalogRef == "" || pkgName == "" || bundleName == "" {
+		return nil, fmt.Errorf("catalog reference, package name, and bundle name are required")
+	}
+	c.log.Debugf("Showing bundle %s of package %s in catalog %s...", bundleName, pkgName, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, ok := bundlesByName(cfg, pkgName)[bundleName]
+	if !ok {
+		return nil, fmt.Errorf("bundle %q for package %q not found", bundleName, pkgName)
+	}
+
+	props, err := property.Parse(bundle.Properties)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse properties of bundle %q: %w", bundleName, err)
+	}
+
+	details := &BundleDetails{
+		Name:             bundle.Name,
+		Package:          bundle.Package,
+		Version:          bundleVersion(bundle),
+		Image:            bundle.Image,
+		ProvidedGVKs:     props.GVKs,
+		RequiredGVKs:     props.GVKsRequired,
+		RequiredPackages: props.PackagesRequired,
+		RelatedImages:    bundle.RelatedImages,
+	}
+	if metadata := csvMetadata(bundle); metadata != nil {
+		details.CSVMetadata = metadata
+		details.MinKubeVersion = metadata.MinKubeVersion
+	}
+	for _, prop := range bundle.Properties {
+		if prop.Type != property.TypeBundleObject {
+			details.Properties = append(details.Properties, prop)
+		}
+	}
+	for _, ch := range cfg.Channels {
+		if ch.Package != pkgName {
+			continue
+		}
+		for _, entry := range ch.Entries {
+			if entry.Name == bundleName {
+				details.Channels = append(details.Channels, ch.Name)
+				break
+			}
+		}
+	}
+
+	return details, nil
+}
@@ -0,0 +1,138 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBundleDetails(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	csvMetadata := property.MustBuildCSVMetadata(v1alpha1.ClusterServiceVersion{
+		Spec: v1alpha1.ClusterServiceVersionSpec{
+			DisplayName:    "Package One",
+			MinKubeVersion: "1.25.0",
+			Provider:       v1alpha1.AppLink{Name: "Example Inc."},
+		},
+	})
+	cfg := &declcfg.DeclarativeConfig{
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "pkg1", Entries: []declcfg.ChannelEntry{{Name: "pkg1.v1.0.0"}}},
+			{Name: "fast", Package: "pkg1", Entries: []declcfg.ChannelEntry{{Name: "pkg1.v1.0.0"}, {Name: "pkg1.v1.1.0"}}},
+			{Name: "stable", Package: "pkg2", Entries: []declcfg.ChannelEntry{{Name: "pkg1.v1.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			{
+				Name:    "pkg1.v1.0.0",
+				Package: "pkg1",
+				Image:   "quay.io/example/pkg1-bundle:v1.0.0",
+				Properties: []property.Property{
+					property.MustBuildPackage("pkg1", "1.0.0"),
+					property.MustBuildGVK("example.com", "v1", "Widget"),
+					property.MustBuildGVKRequired("other.example.com", "v1beta1", "Gadget"),
+					property.MustBuildPackageRequired("pkg2", ">=2.0.0"),
+					property.MustBuildBundleObject([]byte(`{"kind":"ClusterServiceVersion"}`)),
+					csvMetadata,
+				},
+				RelatedImages: []declcfg.RelatedImage{{Name: "operator", Image: "quay.io/example/pkg1:v1.0.0"}},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		catalogRef    string
+		packageName   string
+		bundleName    string
+		setupMocks    func(m *mock.MockCataloger)
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:        "Success Case - Bundle Found",
+			catalogRef:  "test-catalog:latest",
+			packageName: "pkg1",
+			bundleName:  "pkg1.v1.0.0",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(cfg, nil)
+			},
+		},
+		{
+			name:        "Failure Case - Bundle Not Found",
+			catalogRef:  "test-catalog:latest",
+			packageName: "pkg2",
+			bundleName:  "pkg1.v1.0.0",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `bundle "pkg1.v1.0.0" for package "pkg2" not found`,
+		},
+		{
+			name:        "Failure Case - CatalogConfig returns error",
+			catalogRef:  "test-catalog:latest",
+			packageName: "pkg1",
+			bundleName:  "pkg1.v1.0.0",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+		{
+			name:          "Failure Case - Missing BundleName",
+			catalogRef:    "test-catalog:latest",
+			packageName:   "pkg1",
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "catalog reference, package name, and bundle name are required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.BundleDetails(tc.catalogRef, tc.packageName, tc.bundleName)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "pkg1.v1.0.0", result.Name)
+			assert.Equal(t, "1.0.0", result.Version)
+			assert.Equal(t, "quay.io/example/pkg1-bundle:v1.0.0", result.Image)
+			assert.Equal(t, []string{"stable", "fast"}, result.Channels)
+			assert.Equal(t, "1.25.0", result.MinKubeVersion)
+			require.NotNil(t, result.CSVMetadata)
+			assert.Equal(t, "Package One", result.CSVMetadata.DisplayName)
+			assert.Equal(t, []property.GVK{{Group: "example.com", Version: "v1", Kind: "Widget"}}, result.ProvidedGVKs)
+			assert.Equal(t, []property.GVKRequired{{Group: "other.example.com", Version: "v1beta1", Kind: "Gadget"}}, result.RequiredGVKs)
+			assert.Equal(t, []property.PackageRequired{{PackageName: "pkg2", VersionRange: ">=2.0.0"}}, result.RequiredPackages)
+			assert.Equal(t, cfg.Bundles[0].RelatedImages, result.RelatedImages)
+			// The bundle object is left out of the properties.
+			assert.Len(t, result.Properties, 5)
+			for _, prop := range result.Properties {
+				assert.NotEqual(t, property.TypeBundleObject, prop.Type)
+			}
+		})
+	}
+}
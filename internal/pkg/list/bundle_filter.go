@@ -0,0 +1,40 @@
+package list
+
+import (
+	"fmt"
+)
+
+// BundleFilter narrows down the bundles of a channel. Zero values do not filter.
+type BundleFilter struct {
+	MinVersion string
+	MaxVersion string
+	// Latest keeps only the given number of newest bundles.
+	Latest int
+}
+
+// FilterBundles returns the channel entries matching the filter. Entries are expected
+// in the order returned by BundleVersionsByChannel, oldest first.
+func FilterBundles(entries []ChannelEntry, filter BundleFilter) ([]ChannelEntry, error) {
+	if filter.Latest < 0 {
+		return nil, fmt.Errorf("the number of latest bundles must be positive, got %d", filter.Latest)
+	}
+	inRange, err := versionFilter(filter.MinVersion, filter.MaxVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []ChannelEntry
+	for _, entry := range entries {
+		version := entry.Version
+		if v, ok := entryVersion(entry); ok {
+			version = v.String()
+		}
+		if inRange(version) {
+			filtered = append(filtered, entry)
+		}
+	}
+	if filter.Latest > 0 && len(filtered) > filter.Latest {
+		filtered = filtered[len(filtered)-filter.Latest:]
+	}
+	return filtered, nil
+}
@@ -0,0 +1,151 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestBundleVersionsByChannel_SemverOrder(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	bundle := func(name, version string) declcfg.Bundle {
+		b := declcfg.Bundle{Name: name, Package: "pkg1"}
+		if version != "" {
+			b.Properties = []property.Property{property.MustBuildPackage("pkg1", version)}
+		}
+		return b
+	}
+	cfg := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "pkg1", DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{{
+			Name:    "stable",
+			Package: "pkg1",
+			Entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.10.0"},
+				{Name: "pkg1-custom-build"},
+				{Name: "pkg1.v1.2.0"},
+				{Name: "pkg1.v1.9.0"},
+				{Name: "pkg1.v2.0.0-rc.1"},
+				{Name: "pkg1.v2.0.0"},
+			},
+		}},
+		Bundles: []declcfg.Bundle{
+			bundle("pkg1.v1.10.0", "1.10.0"),
+			bundle("pkg1-custom-build", ""),
+			bundle("pkg1.v1.2.0", "1.2.0"),
+			// Without an olm.package version, the version is read from the bundle name.
+			bundle("pkg1.v1.9.0", ""),
+			bundle("pkg1.v2.0.0-rc.1", "2.0.0-rc.1"),
+			bundle("pkg1.v2.0.0", "2.0.0"),
+		},
+	}
+
+	mockCataloger := mock.NewMockCataloger(mockCtrl)
+	mockCataloger.EXPECT().CatalogConfig("test-catalog:latest").Return(cfg, nil)
+	lister := NewCatalogLister(log.New("error"), mockCataloger, nil)
+
+	entries, err := lister.BundleVersionsByChannel("test-catalog:latest", "pkg1", "stable")
+	require.NoError(t, err)
+
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	assert.Equal(t, []string{"pkg1.v1.2.0", "pkg1.v1.9.0", "pkg1.v1.10.0", "pkg1.v2.0.0-rc.1", "pkg1.v2.0.0", "pkg1-custom-build"}, names)
+}
+
+func TestEntryVersion(t *testing.T) {
+	testCases := []struct {
+		entry    ChannelEntry
+		expected string
+	}{
+		{entry: ChannelEntry{Name: "pkg1.v1.2.3", Version: "1.2.4"}, expected: "1.2.4"},
+		{entry: ChannelEntry{Name: "pkg1.v1.2.3"}, expected: "1.2.3"},
+		{entry: ChannelEntry{Name: "foo.vault-operator.v1.2.3"}, expected: "1.2.3"},
+		{entry: ChannelEntry{Name: "etcdoperator.0.9.4"}, expected: "0.9.4"},
+		{entry: ChannelEntry{Name: "pkg1-custom-build"}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.entry.Name, func(t *testing.T) {
+			v, ok := entryVersion(tc.entry)
+			if tc.expected == "" {
+				assert.False(t, ok)
+				return
+			}
+			require.True(t, ok)
+			assert.Equal(t, tc.expected, v.String())
+		})
+	}
+}
+
+func TestFilterBundles(t *testing.T) {
+	entries := []ChannelEntry{
+		{Name: "pkg1.v1.2.0", Version: "1.2.0"},
+		{Name: "pkg1.v1.9.0"},
+		{Name: "pkg1.v1.10.0", Version: "1.10.0"},
+		{Name: "pkg1.v2.0.0", Version: "2.0.0"},
+		{Name: "pkg1-custom-build"},
+	}
+
+	testCases := []struct {
+		name          string
+		filter        BundleFilter
+		expected      []string
+		expectedError string
+	}{
+		{
+			name:     "No Filter",
+			expected: []string{"pkg1.v1.2.0", "pkg1.v1.9.0", "pkg1.v1.10.0", "pkg1.v2.0.0", "pkg1-custom-build"},
+		},
+		{
+			name:     "Version Range",
+			filter:   BundleFilter{MinVersion: "1.9", MaxVersion: "v1.10.0"},
+			expected: []string{"pkg1.v1.9.0", "pkg1.v1.10.0"},
+		},
+		{
+			name:     "Latest Within Range",
+			filter:   BundleFilter{MinVersion: "1.0.0", Latest: 2},
+			expected: []string{"pkg1.v1.10.0", "pkg1.v2.0.0"},
+		},
+		{
+			name:     "Latest Larger Than Entries",
+			filter:   BundleFilter{MaxVersion: "1.5.0", Latest: 10},
+			expected: []string{"pkg1.v1.2.0"},
+		},
+		{
+			name:          "Negative Latest",
+			filter:        BundleFilter{Latest: -1},
+			expectedError: "the number of latest bundles must be positive, got -1",
+		},
+		{
+			name:          "Invalid Maximum Version",
+			filter:        BundleFilter{MaxVersion: "next"},
+			expectedError: `invalid maximum version "next"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			filtered, err := FilterBundles(entries, tc.filter)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			var names []string
+			for _, entry := range filtered {
+				names = append(names, entry.Name)
+			}
+			assert.Equal(t, tc.expected, names)
+		})
+	}
+}
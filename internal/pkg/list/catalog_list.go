@@ -2,6 +2,7 @@ package list
 
 import (
 	"fmt"
+	"strings"
 	"sync"
 )
 
@@ -9,17 +10,36 @@ import (
 type Package struct {
 	Name           string
 	DefaultChannel string
+	// Architectures and OperatingSystems are supported by the head of the default channel,
+	// amd64 and linux when it has no CSV metadata.
+	Architectures    []string
+	OperatingSystems []string
+	// Deprecation is the deprecation message of the package, empty when it is not deprecated.
+	Deprecation string
 }
 
 // Channel represents a channel in a package.
 type Channel struct {
 	Name string
 	Head string
+	// Deprecation is the deprecation message of the channel, empty when it is not deprecated.
+	Deprecation string
 }
 
 // ChannelEntry represents a bundle version in a channel.
 type ChannelEntry struct {
-	Name string
+	Name      string
+	Version   string
+	Image     string
+	Replaces  string
+	Skips     []string
+	SkipRange string
+	// Architectures and OperatingSystems are supported by the bundle, amd64 and linux when it
+	// has no CSV metadata.
+	Architectures    []string
+	OperatingSystems []string
+	// Deprecation is the deprecation message of the bundle, empty when it is not deprecated.
+	Deprecation string
 }
 
 // CatalogLister holds dependencies for listing operations.
@@ -38,41 +58,56 @@ func NewCatalogLister(log Logger, cataloger Cataloger, imager Imager) *CatalogLi
 	}
 }
 
-func (c *CatalogLister) Catalogs(version string) ([]string, error) {
+// Catalog is a catalog image published by a catalog source for an OpenShift version.
+type Catalog struct {
+	Reference   string `json:"reference"`
+	Source      string `json:"source"`
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Catalogs returns the catalogs of the given sources that exist for an OpenShift version,
+// in source order. The Red Hat catalogs are checked when no source is given.
+func (c *CatalogLister) Catalogs(version string, sources []CatalogSource) ([]Catalog, error) {
 	if len(version) == 0 {
 		return nil, fmt.Errorf("a version is required when listing catalogs")
 	}
+	if len(sources) == 0 {
+		sources = DefaultCatalogSources()
+	}
 	c.log.Debugf("Searching for operator catalogs for version %s...", version)
-	repos := []string{
-		"registry.redhat.io/redhat/redhat-operator-index",
-		"registry.redhat.io/redhat/certified-operator-index",
-		"registry.redhat.io/redhat/community-operator-index",
-		"registry.redhat.io/redhat/redhat-marketplace-index",
+
+	imageRefs := make([]string, len(sources))
+	for i, source := range sources {
+		imageRef, err := source.ImageRef(version)
+		if err != nil {
+			return nil, err
+		}
+		imageRefs[i] = imageRef
 	}
 
-	tag := "v" + version
 	var wg sync.WaitGroup
-	catalogsCh := make(chan string, len(repos))
+	found := make([]*Catalog, len(sources))
 
-	for _, repo := range repos {
+	for i, source := range sources {
 		wg.Add(1)
-		go func(repo string) {
+		go func(i int, source CatalogSource) {
 			defer wg.Done()
-			imageRef := fmt.Sprintf("%s:%s", repo, tag)
-			if _, _, _, err := c.imager.RemoteInfo(imageRef); err == nil {
-				catalogsCh <- imageRef
+			if _, _, _, err := c.imager.RemoteInfo(imageRefs[i]); err == nil {
+				found[i] = &Catalog{Reference: imageRefs[i], Source: source.Name, Label: source.Label, Description: source.Description}
 			} else {
-				c.log.Debugf("Catalog %s not found, skipping...", imageRef)
+				c.log.Debugf("Catalog %s not found, skipping...", imageRefs[i])
 			}
-		}(repo)
+		}(i, source)
 	}
 
 	wg.Wait()
-	close(catalogsCh)
 
-	var catalogs []string
-	for catalog := range catalogsCh {
-		catalogs = append(catalogs, catalog)
+	var catalogs []Catalog
+	for _, catalog := range found {
+		if catalog != nil {
+			catalogs = append(catalogs, *catalog)
+		}
 	}
 
 	if len(catalogs) == 0 {
@@ -93,11 +128,17 @@ func (c *CatalogLister) PackagesByCatalog(catalogRef string) ([]Package, error)
 		return nil, err
 	}
 
+	deprecations := newDeprecationIndex(cfg)
+	bundles := bundlesByPackage(cfg)
 	var packages []Package
 	for _, pkg := range cfg.Packages {
+		archs, oses := platforms(csvMetadata(defaultChannelHead(cfg, pkg, bundles[pkg.Name])))
 		packages = append(packages, Package{
-			Name:           pkg.Name,
-			DefaultChannel: pkg.DefaultChannel,
+			Name:             pkg.Name,
+			DefaultChannel:   pkg.DefaultChannel,
+			Architectures:    archs,
+			OperatingSystems: oses,
+			Deprecation:      deprecations.message(pkg.Name, DeprecationPackage, pkg.Name),
 		})
 	}
 
@@ -128,16 +169,18 @@ func (c *CatalogLister) ChannelsByPackage(catalogRef, pkgName string) ([]Channel
 		return nil, fmt.Errorf("package %q not found in catalog %q", pkgName, catalogRef)
 	}
 
+	deprecations := newDeprecationIndex(cfg)
 	var channels []Channel
 	for _, ch := range cfg.Channels {
 		if ch.Package == pkgName {
-			var head string
-			if len(ch.Entries) > 0 {
-				head = ch.Entries[len(ch.Entries)-1].Name
+			heads, err := ChannelHeads(ch)
+			if err != nil {
+				c.log.Warnf("%v", err)
 			}
 			channels = append(channels, Channel{
-				Name: ch.Name,
-				Head: head,
+				Name:        ch.Name,
+				Head:        strings.Join(heads, ","),
+				Deprecation: deprecations.message(pkgName, DeprecationChannel, ch.Name),
 			})
 		}
 	}
@@ -146,6 +189,7 @@ func (c *CatalogLister) ChannelsByPackage(catalogRef, pkgName string) ([]Channel
 	return channels, nil
 }
 
+// BundleVersionsByChannel lists the bundles of a channel sorted by semantic version, oldest first.
 func (c *CatalogLister) BundleVersionsByChannel(catalogRef, pkgName, channelName string) ([]ChannelEntry, error) {
 	if catalogRef == "" || pkgName == "" || channelName == "" {
 		return nil, fmt.Errorf("catalog reference, package name, and channel name are required")
@@ -156,14 +200,27 @@ func (c *CatalogLister) BundleVersionsByChannel(catalogRef, pkgName, channelName
 		return nil, err
 	}
 
+	bundles := bundlesByName(cfg, pkgName)
+	deprecations := newDeprecationIndex(cfg)
 	for _, ch := range cfg.Channels {
 		if ch.Package == pkgName && ch.Name == channelName {
 			var entries []ChannelEntry
 			for _, entry := range ch.Entries {
-				entries = append(entries, ChannelEntry{
-					Name: entry.Name,
-				})
+				channelEntry := ChannelEntry{
+					Name:        entry.Name,
+					Replaces:    entry.Replaces,
+					Skips:       entry.Skips,
+					SkipRange:   entry.SkipRange,
+					Deprecation: deprecations.message(pkgName, DeprecationBundle, entry.Name),
+				}
+				if bundle, ok := bundles[entry.Name]; ok {
+					channelEntry.Version = bundleVersion(bundle)
+					channelEntry.Image = bundle.Image
+					channelEntry.Architectures, channelEntry.OperatingSystems = platforms(csvMetadata(bundle))
+				}
+				entries = append(entries, channelEntry)
 			}
+			sortChannelEntries(entries)
 			c.log.Debugf("Found %d bundle versions.", len(entries))
 			return entries, nil
 		}
@@ -8,6 +8,7 @@ import (
 	"github.com/aguidirh/lumen/internal/pkg/log"
 	"github.com/opencontainers/go-digest"
 	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
 )
@@ -22,8 +23,9 @@ func TestCatalogs(t *testing.T) {
 	testCases := []struct {
 		name          string
 		version       string
+		sources       []CatalogSource
 		setupMocks    func(m *mock.MockImager)
-		expected      []string
+		expected      []Catalog
 		expectErr     bool
 		expectedError string
 	}{
@@ -33,14 +35,29 @@ func TestCatalogs(t *testing.T) {
 			setupMocks: func(m *mock.MockImager) {
 				m.EXPECT().RemoteInfo(gomock.Any()).Return("name", "tag", digest.FromString("sha256:123"), nil).Times(4)
 			},
-			expected: []string{
-				"registry.redhat.io/redhat/redhat-operator-index:v4.16",
-				"registry.redhat.io/redhat/certified-operator-index:v4.16",
-				"registry.redhat.io/redhat/community-operator-index:v4.16",
-				"registry.redhat.io/redhat/redhat-marketplace-index:v4.16",
+			expected: []Catalog{
+				{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.16", Source: "redhat", Label: "Red Hat Operators", Description: "Operators packaged and supported by Red Hat"},
+				{Reference: "registry.redhat.io/redhat/certified-operator-index:v4.16", Source: "certified", Label: "Certified Operators", Description: "Operators from partners certified by Red Hat"},
+				{Reference: "registry.redhat.io/redhat/community-operator-index:v4.16", Source: "community", Label: "Community Operators", Description: "Operators maintained by the community, without Red Hat support"},
+				{Reference: "registry.redhat.io/redhat/redhat-marketplace-index:v4.16", Source: "marketplace", Label: "Red Hat Marketplace", Description: "Operators purchasable through Red Hat Marketplace"},
 			},
 			expectErr: false,
 		},
+		{
+			name:    "Success Case - Custom Sources With Tag Templates",
+			version: "4.16",
+			sources: []CatalogSource{
+				{Name: "okd", Label: "OKD Community", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}-okd"},
+				{Name: "internal", Repository: "registry.example.com/catalogs/internal"},
+			},
+			setupMocks: func(m *mock.MockImager) {
+				m.EXPECT().RemoteInfo("quay.io/okd/community-index:4.16-okd").Return("name", "tag", digest.FromString("sha256:123"), nil)
+				m.EXPECT().RemoteInfo("registry.example.com/catalogs/internal:v4.16").Return("", "", digest.Digest(""), errors.New("not found"))
+			},
+			expected: []Catalog{
+				{Reference: "quay.io/okd/community-index:4.16-okd", Source: "okd", Label: "OKD Community"},
+			},
+		},
 		{
 			name:    "Failure Case - No Catalogs Found",
 			version: "4.16",
@@ -51,6 +68,14 @@ func TestCatalogs(t *testing.T) {
 			expectErr:     true,
 			expectedError: "no catalogs found for version 4.16",
 		},
+		{
+			name:          "Failure Case - Version Does Not Fit Tag Template",
+			version:       "latest",
+			sources:       []CatalogSource{{Name: "okd", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}"}},
+			setupMocks:    func(m *mock.MockImager) {},
+			expectErr:     true,
+			expectedError: `invalid version "latest" for tag template "{major}.{minor}" of catalog source "okd"`,
+		},
 		{
 			name:          "Failure Case - No Version Provided",
 			version:       "",
@@ -70,14 +95,14 @@ func TestCatalogs(t *testing.T) {
 
 			tc.setupMocks(mockImager)
 
-			result, err := lister.Catalogs(tc.version)
+			result, err := lister.Catalogs(tc.version, tc.sources)
 
 			if tc.expectErr {
 				assert.Error(t, err)
 				assert.EqualError(t, err, tc.expectedError)
 			} else {
 				assert.NoError(t, err)
-				assert.ElementsMatch(t, tc.expected, result)
+				assert.Equal(t, tc.expected, result)
 			}
 		})
 	}
@@ -110,8 +135,8 @@ func TestPackagesByCatalog(t *testing.T) {
 				}, nil)
 			},
 			expected: []Package{
-				{Name: "pkg1", DefaultChannel: "stable"},
-				{Name: "pkg2", DefaultChannel: "beta"},
+				{Name: "pkg1", DefaultChannel: "stable", Architectures: []string{"amd64"}, OperatingSystems: []string{"linux"}},
+				{Name: "pkg2", DefaultChannel: "beta", Architectures: []string{"amd64"}, OperatingSystems: []string{"linux"}},
 			},
 			expectErr: false,
 		},
@@ -202,6 +227,39 @@ func TestChannelsByPackage(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name:        "Success Case - Heads Computed From Upgrade Graph",
+			catalogRef:  "test-catalog:latest",
+			packageName: "pkg1",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(&declcfg.DeclarativeConfig{
+					Packages: []declcfg.Package{{Name: "pkg1"}},
+					Channels: []declcfg.Channel{
+						{
+							Name:    "stable",
+							Package: "pkg1",
+							Entries: []declcfg.ChannelEntry{
+								{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0"},
+								{Name: "pkg1.v1.0.0"},
+							},
+						},
+						{
+							Name:    "candidate",
+							Package: "pkg1",
+							Entries: []declcfg.ChannelEntry{
+								{Name: "pkg1.v1.0.0"},
+								{Name: "pkg1.v2.0.0"},
+							},
+						},
+					},
+				}, nil)
+			},
+			expected: []Channel{
+				{Name: "stable", Head: "pkg1.v1.1.0"},
+				{Name: "candidate", Head: "pkg1.v1.0.0,pkg1.v2.0.0"},
+			},
+			expectErr: false,
+		},
 		{
 			name:        "Success Case - No Channels Found",
 			catalogRef:  "test-catalog:latest",
@@ -329,6 +387,66 @@ func TestBundleVersionsByChannel(t *testing.T) {
 			},
 			expectErr: false,
 		},
+		{
+			name:        "Success Case - Bundle Details Found",
+			catalogRef:  "test-catalog:latest",
+			packageName: "pkg1",
+			channelName: "stable",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(&declcfg.DeclarativeConfig{
+					Channels: []declcfg.Channel{
+						{
+							Name:    "stable",
+							Package: "pkg1",
+							Entries: []declcfg.ChannelEntry{
+								{Name: "pkg1.v1.0.0"},
+								{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0", Skips: []string{"pkg1.v1.0.1"}, SkipRange: ">=1.0.0 <1.1.0"},
+							},
+						},
+					},
+					Bundles: []declcfg.Bundle{
+						{
+							Name:       "pkg1.v1.0.0",
+							Package:    "pkg1",
+							Image:      "quay.io/example/pkg1-bundle:v1.0.0",
+							Properties: []property.Property{property.MustBuildPackage("pkg1", "1.0.0")},
+						},
+						{
+							Name:       "pkg1.v1.1.0",
+							Package:    "pkg1",
+							Image:      "quay.io/example/pkg1-bundle:v1.1.0",
+							Properties: []property.Property{property.MustBuildPackage("pkg1", "1.1.0")},
+						},
+						{
+							// A bundle with the same name in another package must be ignored.
+							Name:    "pkg1.v1.1.0",
+							Package: "pkg2",
+							Image:   "quay.io/example/pkg2-bundle:v1.1.0",
+						},
+					},
+				}, nil)
+			},
+			expected: []ChannelEntry{
+				{
+					Name:             "pkg1.v1.0.0",
+					Version:          "1.0.0",
+					Image:            "quay.io/example/pkg1-bundle:v1.0.0",
+					Architectures:    []string{"amd64"},
+					OperatingSystems: []string{"linux"},
+				},
+				{
+					Name:             "pkg1.v1.1.0",
+					Version:          "1.1.0",
+					Image:            "quay.io/example/pkg1-bundle:v1.1.0",
+					Replaces:         "pkg1.v1.0.0",
+					Skips:            []string{"pkg1.v1.0.1"},
+					SkipRange:        ">=1.0.0 <1.1.0",
+					Architectures:    []string{"amd64"},
+					OperatingSystems: []string{"linux"},
+				},
+			},
+			expectErr: false,
+		},
 		{
 			name:        "Success Case - No Bundle Versions Found",
 			catalogRef:  "test-catalog:latest",
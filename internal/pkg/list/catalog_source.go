@@ -0,0 +1,193 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultTagTemplate is the tag template of catalog sources that do not set one.
+const DefaultTagTemplate = "v{version}"
+
+// CatalogSource is a catalog repository published once per OpenShift version.
+// TagTemplate builds the tag of a version and accepts the {version}, {major}
+// and {minor} placeholders, e.g. "v{version}" or "{major}.{minor}-okd".
+type CatalogSource struct {
+	Name        string `json:"name"`
+	Label       string `json:"label,omitempty"`
+	Description string `json:"description,omitempty"`
+	Repository  string `json:"repository"`
+	TagTemplate string `json:"tagTemplate,omitempty"`
+}
+
+// CatalogSourcesConfig is the content of the lumen configuration file.
+type CatalogSourcesConfig struct {
+	CatalogSources []CatalogSource `json:"catalogSources"`
+}
+
+// DefaultCatalogSources returns the Red Hat OperatorHub catalogs.
+func DefaultCatalogSources() []CatalogSource {
+	return []CatalogSource{
+		{
+			Name:        "redhat",
+			Label:       "Red Hat Operators",
+			Description: "Operators packaged and supported by Red Hat",
+			Repository:  "registry.redhat.io/redhat/redhat-operator-index",
+		},
+		{
+			Name:        "certified",
+			Label:       "Certified Operators",
+			Description: "Operators from partners certified by Red Hat",
+			Repository:  "registry.redhat.io/redhat/certified-operator-index",
+		},
+		{
+			Name:        "community",
+			Label:       "Community Operators",
+			Description: "Operators maintained by the community, without Red Hat support",
+			Repository:  "registry.redhat.io/redhat/community-operator-index",
+		},
+		{
+			Name:        "marketplace",
+			Label:       "Red Hat Marketplace",
+			Description: "Operators purchasable through Red Hat Marketplace",
+			Repository:  "registry.redhat.io/redhat/redhat-marketplace-index",
+		},
+	}
+}
+
+// Tag returns the tag of the catalog image for an OpenShift version.
+func (s CatalogSource) Tag(version string) (string, error) {
+	template := s.TagTemplate
+	if template == "" {
+		template = DefaultTagTemplate
+	}
+	tag := strings.ReplaceAll(template, "{version}", version)
+	if strings.Contains(tag, "{major}") || strings.Contains(tag, "{minor}") {
+		v, ok := parseVersion(version)
+		if !ok {
+			return "", fmt.Errorf("invalid version %q for tag template %q of catalog source %q", version, template, s.Name)
+		}
+		tag = strings.ReplaceAll(tag, "{major}", fmt.Sprint(v.Major))
+		tag = strings.ReplaceAll(tag, "{minor}", fmt.Sprint(v.Minor))
+	}
+	return tag, nil
+}
+
+// ImageRef returns the catalog image reference for an OpenShift version.
+func (s CatalogSource) ImageRef(version string) (string, error) {
+	tag, err := s.Tag(version)
+	if err != nil {
+		return "", err
+	}
+	return s.Repository + ":" + tag, nil
+}
+
+// LoadCatalogSources reads the catalog sources from a YAML or JSON configuration file.
+func LoadCatalogSources(path string) ([]CatalogSource, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+	var config CatalogSourcesConfig
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	if len(config.CatalogSources) == 0 {
+		return nil, fmt.Errorf("config file %s does not define any catalog source", path)
+	}
+	seen := make(map[string]bool)
+	for _, source := range config.CatalogSources {
+		if source.Name == "" || source.Repository == "" {
+			return nil, fmt.Errorf("catalog sources in config file %s require a name and a repository", path)
+		}
+		if seen[source.Name] {
+			return nil, fmt.Errorf("catalog source %q is defined more than once in config file %s", source.Name, path)
+		}
+		seen[source.Name] = true
+	}
+	return config.CatalogSources, nil
+}
+
+// SelectCatalogSources returns the sources matching the selectors, in selector order.
+// A selector is either the name of a source or an ad hoc repository, optionally followed
+// by a tag template, e.g. "quay.io/example/catalog" or "quay.io/example/catalog:{version}".
+// Without selectors every source is returned.
+func SelectCatalogSources(sources []CatalogSource, selectors []string) ([]CatalogSource, error) {
+	if len(selectors) == 0 {
+		return sources, nil
+	}
+	var selected []CatalogSource
+	for _, selector := range selectors {
+		if source, ok := findCatalogSource(sources, selector); ok {
+			selected = append(selected, source)
+			continue
+		}
+		if !strings.Contains(selector, "/") {
+			return nil, fmt.Errorf("unknown catalog source %q", selector)
+		}
+		source := CatalogSource{Name: selector, Repository: selector}
+		// A colon after the last slash separates the tag template from the repository.
+		if i := strings.LastIndex(selector, ":"); i > strings.LastIndex(selector, "/") {
+			source.Repository, source.TagTemplate = selector[:i], selector[i+1:]
+		}
+		selected = append(selected, source)
+	}
+	return selected, nil
+}
+
+func findCatalogSource(sources []CatalogSource, name string) (CatalogSource, bool) {
+	for _, source := range sources {
+		if source.Name == name {
+			return source, true
+		}
+	}
+	return CatalogSource{}, false
+}
+
+// defaultTagPattern matches the tags built from DefaultTagTemplate.
+var defaultTagPattern = compileTagPattern(DefaultTagTemplate)
+
+// compileTagPattern returns the regular expression matching the tags built from a tag template,
+// or nil when the template does not hold both the major and the minor version.
+func compileTagPattern(template string) *regexp.Regexp {
+	pattern := regexp.QuoteMeta(template)
+	pattern = strings.ReplaceAll(pattern, regexp.QuoteMeta("{version}"), `(?P<major>\d+)\.(?P<minor>\d+)`)
+	pattern = strings.Replace(pattern, regexp.QuoteMeta("{major}"), `(?P<major>\d+)`, 1)
+	pattern = strings.Replace(pattern, regexp.QuoteMeta("{minor}"), `(?P<minor>\d+)`, 1)
+	re, err := regexp.Compile("^" + pattern + "$")
+	if err != nil || re.SubexpIndex("major") < 0 || re.SubexpIndex("minor") < 0 {
+		return nil
+	}
+	return re
+}
+
+// tagPattern returns the regular expression matching the tags of the source, compiled once for
+// the default template.
+func (s CatalogSource) tagPattern() *regexp.Regexp {
+	if s.TagTemplate == "" || s.TagTemplate == DefaultTagTemplate {
+		return defaultTagPattern
+	}
+	return compileTagPattern(s.TagTemplate)
+}
+
+// VersionFromTag returns the OpenShift version of a tag built from the tag template,
+// e.g. "4.16" for "v4.16" with the default template. Tags that do not match the
+// template, such as digests or per-build tags, are rejected.
+func (s CatalogSource) VersionFromTag(tag string) (string, bool) {
+	return versionFromTag(s.tagPattern(), tag)
+}
+
+// versionFromTag returns the OpenShift version of a tag matched by a tag pattern.
+func versionFromTag(re *regexp.Regexp, tag string) (string, bool) {
+	if re == nil {
+		return "", false
+	}
+	match := re.FindStringSubmatch(tag)
+	if match == nil {
+		return "", false
+	}
+	return match[re.SubexpIndex("major")] + "." + match[re.SubexpIndex("minor")], true
+}
@@ -0,0 +1,119 @@
+package list
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadCatalogSources(t *testing.T) {
+	testCases := []struct {
+		name          string
+		content       string
+		expected      []CatalogSource
+		expectedError string
+	}{
+		{
+			name: "Success Case",
+			content: `
+catalogSources:
+- name: okd
+  label: OKD Community
+  description: Community operators built for OKD
+  repository: quay.io/okd/community-index
+  tagTemplate: "{major}.{minor}"
+- name: internal
+  repository: registry.example.com/catalogs/internal
+`,
+			expected: []CatalogSource{
+				{Name: "okd", Label: "OKD Community", Description: "Community operators built for OKD", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}"},
+				{Name: "internal", Repository: "registry.example.com/catalogs/internal"},
+			},
+		},
+		{
+			name:          "Failure Case - Unknown Field",
+			content:       "catalogSources:\n- name: okd\n  repo: quay.io/okd/community-index\n",
+			expectedError: `unknown field "repo"`,
+		},
+		{
+			name:          "Failure Case - Missing Repository",
+			content:       "catalogSources:\n- name: okd\n",
+			expectedError: "require a name and a repository",
+		},
+		{
+			name:          "Failure Case - Duplicate Name",
+			content:       "catalogSources:\n- name: okd\n  repository: quay.io/a\n- name: okd\n  repository: quay.io/b\n",
+			expectedError: `catalog source "okd" is defined more than once`,
+		},
+		{
+			name:          "Failure Case - No Sources",
+			content:       "catalogSources: []\n",
+			expectedError: "does not define any catalog source",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0644))
+
+			sources, err := LoadCatalogSources(path)
+			if tc.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, sources)
+		})
+	}
+}
+
+func TestSelectCatalogSources(t *testing.T) {
+	sources := DefaultCatalogSources()
+
+	testCases := []struct {
+		name          string
+		selectors     []string
+		expected      []CatalogSource
+		expectedError string
+	}{
+		{
+			name:     "No Selectors",
+			expected: sources,
+		},
+		{
+			name:      "By Name",
+			selectors: []string{"community", "redhat"},
+			expected:  []CatalogSource{sources[2], sources[0]},
+		},
+		{
+			name:      "Ad Hoc Repositories",
+			selectors: []string{"registry.example.com:5000/catalogs/internal", "quay.io/okd/community-index:{major}.{minor}"},
+			expected: []CatalogSource{
+				{Name: "registry.example.com:5000/catalogs/internal", Repository: "registry.example.com:5000/catalogs/internal"},
+				{Name: "quay.io/okd/community-index:{major}.{minor}", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}"},
+			},
+		},
+		{
+			name:          "Unknown Name",
+			selectors:     []string{"partner"},
+			expectedError: `unknown catalog source "partner"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			selected, err := SelectCatalogSources(sources, tc.selectors)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, selected)
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package list
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// loadedCatalog is a catalog loaded by loadCatalogs.
+type loadedCatalog struct {
+	ref string
+	cfg *declcfg.DeclarativeConfig
+}
+
+// loadCatalogs loads several catalogs concurrently and returns them in the given order.
+// Catalogs that cannot be loaded are skipped with a warning, an error is only returned
+// when none of them could be loaded.
+func (c *CatalogLister) loadCatalogs(catalogRefs []string) ([]loadedCatalog, error) {
+	if len(catalogRefs) == 0 {
+		return nil, fmt.Errorf("at least one catalog reference is required")
+	}
+
+	var wg sync.WaitGroup
+	configs := make([]*declcfg.DeclarativeConfig, len(catalogRefs))
+	errs := make([]error, len(catalogRefs))
+	for i, catalogRef := range catalogRefs {
+		wg.Add(1)
+		go func(i int, catalogRef string) {
+			defer wg.Done()
+			configs[i], errs[i] = c.cataloger.CatalogConfig(catalogRef)
+		}(i, catalogRef)
+	}
+	wg.Wait()
+
+	var catalogs []loadedCatalog
+	for i, catalogRef := range catalogRefs {
+		if errs[i] != nil {
+			c.log.Warnf("Skipping catalog %s: %v", catalogRef, errs[i])
+			continue
+		}
+		catalogs = append(catalogs, loadedCatalog{ref: catalogRef, cfg: configs[i]})
+	}
+	if len(catalogs) == 0 {
+		return nil, fmt.Errorf("failed to load any catalog: %w", errs[0])
+	}
+	return catalogs, nil
+}
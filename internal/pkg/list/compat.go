@@ -0,0 +1,187 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// propertyMaxOpenShiftVersion is the bundle property declaring the latest OpenShift minor
+// version an operator can run on, which blocks cluster upgrades past it.
+const propertyMaxOpenShiftVersion = "olm.maxOpenShiftVersion"
+
+// Compatibility statuses of a channel head with an OpenShift version.
+const (
+	CompatCompatible   = "compatible"
+	CompatIncompatible = "incompatible"
+	CompatUnknown      = "unknown"
+)
+
+// CompatibilityReport tells whether the channel heads of a catalog can run on an OpenShift version.
+type CompatibilityReport struct {
+	Catalog    string `json:"catalog"`
+	TargetOCP  string `json:"targetOCP"`
+	TargetKube string `json:"targetKube"`
+	// Results holds a result per channel, sorted by package and channel.
+	Results []ChannelCompatibility `json:"results"`
+}
+
+// Incompatible returns the number of channel heads that cannot run on the target version.
+func (r *CompatibilityReport) Incompatible() int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Status == CompatIncompatible {
+			count++
+		}
+	}
+	return count
+}
+
+// ChannelCompatibility is the compatibility of the head of a channel with the target version.
+type ChannelCompatibility struct {
+	Package             string `json:"package"`
+	Channel             string `json:"channel"`
+	Head                string `json:"head"`
+	Version             string `json:"version,omitempty"`
+	MaxOpenShiftVersion string `json:"maxOpenShiftVersion,omitempty"`
+	MinKubeVersion      string `json:"minKubeVersion,omitempty"`
+	Status              string `json:"status"`
+	// Reason explains an incompatible or unknown status.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Compatibility checks the head of every channel of the given packages, or of every package when
+// none is given, against an OpenShift version. A head is incompatible when its olm.maxOpenShiftVersion
+// property is older than the target minor version, or when the minKubeVersion of its CSV is newer
+// than the Kubernetes version the target ships.
+func (c *CatalogLister) Compatibility(catalogRef, targetOCP string, packages []string) (*CompatibilityReport, error) {
+	if catalogRef == "" || targetOCP == "" {
+		return nil, fmt.Errorf("catalog reference and target OpenShift version are required")
+	}
+	target, ok := parseVersion(targetOCP)
+	if !ok || target.Major != 4 {
+		return nil, fmt.Errorf("invalid OpenShift version %q, expected 4.<minor>", targetOCP)
+	}
+	// OpenShift 4.y ships Kubernetes 1.(y+13).
+	targetKube := semver.Version{Major: 1, Minor: target.Minor + 13}
+	c.log.Debugf("Checking compatibility with OpenShift %s in catalog %s...", targetOCP, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make(map[string]bool, len(packages))
+	for _, name := range packages {
+		if !packageExists(cfg, name) {
+			return nil, fmt.Errorf("package %q not found in catalog %q", name, catalogRef)
+		}
+		selected[name] = true
+	}
+
+	report := &CompatibilityReport{
+		Catalog:    catalogRef,
+		TargetOCP:  fmt.Sprintf("%d.%d", target.Major, target.Minor),
+		TargetKube: fmt.Sprintf("%d.%d", targetKube.Major, targetKube.Minor),
+		Results:    []ChannelCompatibility{},
+	}
+	bundles := bundlesByPackage(cfg)
+	for _, ch := range cfg.Channels {
+		if len(selected) > 0 && !selected[ch.Package] {
+			continue
+		}
+		heads, err := ChannelHeads(ch)
+		if err != nil {
+			c.log.Warnf("%v", err)
+		}
+		if len(heads) == 0 {
+			continue
+		}
+		head := bundles[ch.Package][heads[0]]
+		report.Results = append(report.Results, checkCompatibility(ch, heads[0], head, target, targetKube))
+	}
+
+	sort.SliceStable(report.Results, func(i, j int) bool {
+		if report.Results[i].Package != report.Results[j].Package {
+			return report.Results[i].Package < report.Results[j].Package
+		}
+		return report.Results[i].Channel < report.Results[j].Channel
+	})
+
+	c.log.Debugf("Checked %d channel heads, %d incompatible.", len(report.Results), report.Incompatible())
+	return report, nil
+}
+
+// checkCompatibility checks a channel head against the target OpenShift and Kubernetes versions.
+func checkCompatibility(ch declcfg.Channel, headName string, head *declcfg.Bundle, target, targetKube semver.Version) ChannelCompatibility {
+	result := ChannelCompatibility{Package: ch.Package, Channel: ch.Name, Head: headName, Status: CompatCompatible}
+	if head == nil {
+		result.Status = CompatUnknown
+		result.Reason = "head bundle not found in catalog"
+		return result
+	}
+	result.Version = bundleVersion(head)
+	result.MaxOpenShiftVersion = maxOpenShiftVersion(head)
+	if metadata := csvMetadata(head); metadata != nil {
+		result.MinKubeVersion = metadata.MinKubeVersion
+	}
+
+	var reasons, unknown []string
+	if result.MaxOpenShiftVersion != "" {
+		maxVersion, ok := parseVersion(result.MaxOpenShiftVersion)
+		switch {
+		case !ok:
+			unknown = append(unknown, fmt.Sprintf("invalid maxOpenShiftVersion %q", result.MaxOpenShiftVersion))
+		case maxVersion.Major < target.Major || (maxVersion.Major == target.Major && maxVersion.Minor < target.Minor):
+			reasons = append(reasons, fmt.Sprintf("maxOpenShiftVersion %s is older than %d.%d", result.MaxOpenShiftVersion, target.Major, target.Minor))
+		}
+	}
+	if result.MinKubeVersion != "" {
+		minKube, ok := parseVersion(result.MinKubeVersion)
+		switch {
+		case !ok:
+			unknown = append(unknown, fmt.Sprintf("invalid minKubeVersion %q", result.MinKubeVersion))
+		case minKube.Major > targetKube.Major || (minKube.Major == targetKube.Major && minKube.Minor > targetKube.Minor):
+			reasons = append(reasons, fmt.Sprintf("minKubeVersion %s is newer than %d.%d", result.MinKubeVersion, targetKube.Major, targetKube.Minor))
+		}
+	}
+
+	switch {
+	case len(reasons) > 0:
+		result.Status = CompatIncompatible
+		result.Reason = strings.Join(reasons, ", ")
+	case len(unknown) > 0:
+		result.Status = CompatUnknown
+		result.Reason = strings.Join(unknown, ", ")
+	}
+	return result
+}
+
+// maxOpenShiftVersion returns the olm.maxOpenShiftVersion property of a bundle, or an empty
+// string when it has none. The value is declared either as a JSON string or as a number.
+func maxOpenShiftVersion(b *declcfg.Bundle) string {
+	for _, prop := range b.Properties {
+		if prop.Type != propertyMaxOpenShiftVersion {
+			continue
+		}
+		var version string
+		if err := json.Unmarshal(prop.Value, &version); err == nil {
+			return version
+		}
+		return strings.TrimSpace(string(prop.Value))
+	}
+	return ""
+}
+
+// packageExists reports whether a catalog has a package.
+func packageExists(cfg *declcfg.DeclarativeConfig, pkgName string) bool {
+	for _, pkg := range cfg.Packages {
+		if pkg.Name == pkgName {
+			return true
+		}
+	}
+	return false
+}
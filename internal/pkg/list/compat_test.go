@@ -0,0 +1,155 @@
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// compatTestConfig returns a catalog with channel heads capped by olm.maxOpenShiftVersion or requiring
+// a minimum Kubernetes version.
+func compatTestConfig() *declcfg.DeclarativeConfig {
+	maxOCP := func(value string) property.Property {
+		return property.Property{Type: propertyMaxOpenShiftVersion, Value: json.RawMessage(value)}
+	}
+	minKube := func(version string) property.Property {
+		return property.MustBuildCSVMetadata(v1alpha1.ClusterServiceVersion{Spec: v1alpha1.ClusterServiceVersionSpec{MinKubeVersion: version}})
+	}
+	bundle := func(pkg, version string, props ...property.Property) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:       pkg + ".v" + version,
+			Package:    pkg,
+			Properties: append([]property.Property{property.MustBuildPackage(pkg, version)}, props...),
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Name: "legacy-operator", DefaultChannel: "stable"},
+			{Name: "modern-operator", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "legacy-operator", Entries: []declcfg.ChannelEntry{
+				{Name: "legacy-operator.v1.0.0"},
+				{Name: "legacy-operator.v1.1.0", Replaces: "legacy-operator.v1.0.0"},
+			}},
+			{Name: "fast", Package: "legacy-operator", Entries: []declcfg.ChannelEntry{{Name: "legacy-operator.v2.0.0"}}},
+			{Name: "stable", Package: "modern-operator", Entries: []declcfg.ChannelEntry{{Name: "modern-operator.v1.0.0"}}},
+			{Name: "preview", Package: "modern-operator", Entries: []declcfg.ChannelEntry{{Name: "modern-operator.v2.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("legacy-operator", "1.0.0", maxOCP(`"4.12"`)),
+			bundle("legacy-operator", "1.1.0", maxOCP(`4.16`)),
+			bundle("legacy-operator", "2.0.0", maxOCP(`"4.20"`), minKube("1.25.0")),
+			bundle("modern-operator", "1.0.0", maxOCP(`"next"`)),
+			bundle("modern-operator", "2.0.0", minKube("1.32.0")),
+		},
+	}
+}
+
+func TestCompatibility(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		targetOCP     string
+		packages      []string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      *CompatibilityReport
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:      "Success Case - Every Package",
+			targetOCP: "4.18",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(compatTestConfig(), nil)
+			},
+			expected: &CompatibilityReport{
+				Catalog:    "test-catalog:latest",
+				TargetOCP:  "4.18",
+				TargetKube: "1.31",
+				Results: []ChannelCompatibility{
+					{Package: "legacy-operator", Channel: "fast", Head: "legacy-operator.v2.0.0", Version: "2.0.0", MaxOpenShiftVersion: "4.20", MinKubeVersion: "1.25.0", Status: CompatCompatible},
+					{Package: "legacy-operator", Channel: "stable", Head: "legacy-operator.v1.1.0", Version: "1.1.0", MaxOpenShiftVersion: "4.16", Status: CompatIncompatible, Reason: "maxOpenShiftVersion 4.16 is older than 4.18"},
+					{Package: "modern-operator", Channel: "preview", Head: "modern-operator.v2.0.0", Version: "2.0.0", MinKubeVersion: "1.32.0", Status: CompatIncompatible, Reason: "minKubeVersion 1.32.0 is newer than 1.31"},
+					{Package: "modern-operator", Channel: "stable", Head: "modern-operator.v1.0.0", Version: "1.0.0", MaxOpenShiftVersion: "next", Status: CompatUnknown, Reason: `invalid maxOpenShiftVersion "next"`},
+				},
+			},
+		},
+		{
+			name:      "Success Case - Selected Package",
+			targetOCP: "v4.16.3",
+			packages:  []string{"legacy-operator"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(compatTestConfig(), nil)
+			},
+			expected: &CompatibilityReport{
+				Catalog:    "test-catalog:latest",
+				TargetOCP:  "4.16",
+				TargetKube: "1.29",
+				Results: []ChannelCompatibility{
+					{Package: "legacy-operator", Channel: "fast", Head: "legacy-operator.v2.0.0", Version: "2.0.0", MaxOpenShiftVersion: "4.20", MinKubeVersion: "1.25.0", Status: CompatCompatible},
+					{Package: "legacy-operator", Channel: "stable", Head: "legacy-operator.v1.1.0", Version: "1.1.0", MaxOpenShiftVersion: "4.16", Status: CompatCompatible},
+				},
+			},
+		},
+		{
+			name:      "Failure Case - Package Not Found",
+			targetOCP: "4.18",
+			packages:  []string{"non-existent"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(compatTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "non-existent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name:          "Failure Case - Invalid Target",
+			targetOCP:     "3.11",
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: `invalid OpenShift version "3.11", expected 4.<minor>`,
+		},
+		{
+			name:      "Failure Case - Cataloger Error",
+			targetOCP: "4.18",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Compatibility("test-catalog:latest", tc.targetOCP, tc.packages)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
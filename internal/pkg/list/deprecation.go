@@ -0,0 +1,101 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// Kinds of catalog objects that can be deprecated.
+const (
+	DeprecationPackage = "package"
+	DeprecationChannel = "channel"
+	DeprecationBundle  = "bundle"
+)
+
+// Deprecation is a package, channel or bundle marked as deprecated by an olm.deprecations blob.
+type Deprecation struct {
+	Package string `json:"package"`
+	Kind    string `json:"kind"`
+	Name    string `json:"name"`
+	Message string `json:"message"`
+}
+
+type deprecationKey struct {
+	pkg, kind, name string
+}
+
+// deprecationIndex maps deprecated objects to their deprecation message.
+type deprecationIndex map[deprecationKey]string
+
+// deprecationsOf returns the deprecations declared in a catalog, in catalog order.
+func deprecationsOf(cfg *declcfg.DeclarativeConfig) []Deprecation {
+	var deprecations []Deprecation
+	for _, d := range cfg.Deprecations {
+		for _, entry := range d.Entries {
+			deprecation := Deprecation{Package: d.Package, Name: entry.Reference.Name, Message: entry.Message}
+			switch entry.Reference.Schema {
+			case declcfg.SchemaPackage:
+				deprecation.Kind = DeprecationPackage
+				deprecation.Name = d.Package
+			case declcfg.SchemaChannel:
+				deprecation.Kind = DeprecationChannel
+			case declcfg.SchemaBundle:
+				deprecation.Kind = DeprecationBundle
+			default:
+				continue
+			}
+			deprecations = append(deprecations, deprecation)
+		}
+	}
+	return deprecations
+}
+
+func newDeprecationIndex(cfg *declcfg.DeclarativeConfig) deprecationIndex {
+	index := make(deprecationIndex)
+	for _, d := range deprecationsOf(cfg) {
+		index[deprecationKey{pkg: d.Package, kind: d.Kind, name: d.Name}] = d.Message
+	}
+	return index
+}
+
+// message returns the deprecation message of an object, or an empty string when it is not deprecated.
+func (d deprecationIndex) message(pkg, kind, name string) string {
+	return d[deprecationKey{pkg: pkg, kind: kind, name: name}]
+}
+
+// Deprecations lists the deprecated packages, channels and bundles of a catalog.
+// When pkgName is set, only the deprecations of that package are returned.
+func (c *CatalogLister) Deprecations(catalogRef, pkgName string) ([]Deprecation, error) {
+	if catalogRef == "" {
+		return nil, fmt.Errorf("catalog reference is required")
+	}
+	c.log.Debugf("Listing deprecations in catalog %s...", catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	if pkgName != "" {
+		found := false
+		for _, pkg := range cfg.Packages {
+			if pkg.Name == pkgName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("package %q not found in catalog %q", pkgName, catalogRef)
+		}
+	}
+
+	var deprecations []Deprecation
+	for _, d := range deprecationsOf(cfg) {
+		if pkgName == "" || d.Package == pkgName {
+			deprecations = append(deprecations, d)
+		}
+	}
+
+	c.log.Debugf("Found %d deprecations.", len(deprecations))
+	return deprecations, nil
+}
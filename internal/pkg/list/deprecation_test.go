@@ -0,0 +1,138 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// deprecationTestConfig returns the graph test catalog with a deprecated package,
+// channel and bundle, and a second package without deprecations.
+func deprecationTestConfig() *declcfg.DeclarativeConfig {
+	cfg := graphTestConfig()
+	cfg.Packages = append(cfg.Packages, declcfg.Package{Name: "pkg2", DefaultChannel: "stable"})
+	cfg.Deprecations = []declcfg.Deprecation{{
+		Schema:  declcfg.SchemaDeprecation,
+		Package: "pkg1",
+		Entries: []declcfg.DeprecationEntry{
+			{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaPackage}, Message: "pkg1 is replaced by pkg2"},
+			{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaChannel, Name: "fast"}, Message: "use the stable channel"},
+			{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaBundle, Name: "pkg1.v1.1.0"}, Message: "pkg1.v1.1.0 has a known data loss bug"},
+		},
+	}}
+	return cfg
+}
+
+func TestDeprecations(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		packageName   string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      []Deprecation
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name: "Success Case - All Packages",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(deprecationTestConfig(), nil)
+			},
+			expected: []Deprecation{
+				{Package: "pkg1", Kind: DeprecationPackage, Name: "pkg1", Message: "pkg1 is replaced by pkg2"},
+				{Package: "pkg1", Kind: DeprecationChannel, Name: "fast", Message: "use the stable channel"},
+				{Package: "pkg1", Kind: DeprecationBundle, Name: "pkg1.v1.1.0", Message: "pkg1.v1.1.0 has a known data loss bug"},
+			},
+		},
+		{
+			name:        "Success Case - Package Without Deprecations",
+			packageName: "pkg2",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(deprecationTestConfig(), nil)
+			},
+		},
+		{
+			name:        "Failure Case - Package Not Found",
+			packageName: "nonexistent",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(deprecationTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "nonexistent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name: "Failure Case - CatalogConfig returns error",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Deprecations("test-catalog:latest", tc.packageName)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestDeprecationsInListings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCataloger := mock.NewMockCataloger(mockCtrl)
+	mockCataloger.EXPECT().CatalogConfig("test-catalog:latest").Return(deprecationTestConfig(), nil).Times(3)
+	lister := NewCatalogLister(log.New("error"), mockCataloger, nil)
+
+	packages, err := lister.PackagesByCatalog("test-catalog:latest")
+	require.NoError(t, err)
+	assert.Equal(t, []Package{
+		{Name: "pkg1", DefaultChannel: "stable", Architectures: []string{"amd64"}, OperatingSystems: []string{"linux"}, Deprecation: "pkg1 is replaced by pkg2"},
+		{Name: "pkg2", DefaultChannel: "stable", Architectures: []string{"amd64"}, OperatingSystems: []string{"linux"}},
+	}, packages)
+
+	channels, err := lister.ChannelsByPackage("test-catalog:latest", "pkg1")
+	require.NoError(t, err)
+	assert.Equal(t, []Channel{
+		{Name: "stable", Head: "pkg1.v1.2.0"},
+		{Name: "fast", Head: "pkg1.v1.2.0", Deprecation: "use the stable channel"},
+	}, channels)
+
+	bundles, err := lister.BundleVersionsByChannel("test-catalog:latest", "pkg1", "stable")
+	require.NoError(t, err)
+	deprecated := map[string]string{}
+	for _, b := range bundles {
+		deprecated[b.Name] = b.Deprecation
+	}
+	assert.Equal(t, map[string]string{
+		"pkg1.v1.0.0": "",
+		"pkg1.v1.1.0": "pkg1.v1.1.0 has a known data loss bug",
+		"pkg1.v1.1.1": "",
+		"pkg1.v1.2.0": "",
+	}, deprecated)
+}
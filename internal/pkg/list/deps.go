@@ -0,0 +1,262 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// Kinds of requirement a bundle can declare on other operators.
+const (
+	RequirementPackage = "package"
+	RequirementAPI     = "api"
+)
+
+// DependencyTree is the tree of operators a bundle pulls in when it is installed.
+type DependencyTree struct {
+	Catalog string         `json:"catalog"`
+	Root    DependencyNode `json:"root"`
+	// Unsatisfied is the number of requirements in the tree that no bundle of the catalog satisfies.
+	Unsatisfied int `json:"unsatisfied"`
+}
+
+// DependencyNode is a bundle of the tree, or a requirement that could not be satisfied.
+type DependencyNode struct {
+	// Requirement is the requirement resolved by the node, nil for the root.
+	Requirement *Requirement `json:"requirement,omitempty"`
+	Package     string       `json:"package,omitempty"`
+	Bundle      string       `json:"bundle,omitempty"`
+	Version     string       `json:"version,omitempty"`
+	// Unsatisfied explains why the requirement of the node cannot be satisfied.
+	Unsatisfied string `json:"unsatisfied,omitempty"`
+	// Cycle is set when the bundle is already one of the ancestors of the node, its dependencies are not repeated.
+	Cycle        bool             `json:"cycle,omitempty"`
+	Dependencies []DependencyNode `json:"dependencies,omitempty"`
+}
+
+// Requirement is an olm.package.required or olm.gvk.required property of a bundle.
+type Requirement struct {
+	Type string `json:"type"`
+	// Package and VersionRange are set for package requirements.
+	Package      string `json:"package,omitempty"`
+	VersionRange string `json:"versionRange,omitempty"`
+	// API is set for API requirements.
+	API *property.GVK `json:"api,omitempty"`
+}
+
+// String describes the requirement, e.g. "package etcd >=0.9.0" or "api EtcdCluster.etcd.database.coreos.com/v1beta2".
+func (r Requirement) String() string {
+	if r.Type == RequirementAPI && r.API != nil {
+		return "api " + FormatGVK(*r.API)
+	}
+	if r.VersionRange == "" {
+		return "package " + r.Package
+	}
+	return fmt.Sprintf("package %s %s", r.Package, r.VersionRange)
+}
+
+// Dependencies resolves the olm.package.required and olm.gvk.required properties of a bundle
+// against the catalog into a tree. The head of the default channel of the package is used
+// when no bundle is given. Required packages are satisfied by their default channel head, or
+// by the latest bundle of the default channel within the version range, and required APIs by
+// the default channel head of the first package, by name, that provides them.
+func (c *CatalogLister) Dependencies(catalogRef, pkgName, bundleName string) (*DependencyTree, error) {
+	if catalogRef == "" || pkgName == "" {
+		return nil, fmt.Errorf("catalog reference and package name are required")
+	}
+	c.log.Debugf("Resolving dependencies of package %s in catalog %s...", pkgName, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	r := newDependencyResolver(cfg)
+	pkg, ok := r.packages[pkgName]
+	if !ok {
+		return nil, fmt.Errorf("package %q not found in catalog %q", pkgName, catalogRef)
+	}
+	var root *declcfg.Bundle
+	if bundleName == "" {
+		if root = r.heads[pkg.Name]; root == nil {
+			return nil, fmt.Errorf("cannot determine the head of the default channel %q of package %q", pkg.DefaultChannel, pkgName)
+		}
+	} else if root = bundlesByName(cfg, pkgName)[bundleName]; root == nil {
+		return nil, fmt.Errorf("bundle %q not found in package %q", bundleName, pkgName)
+	}
+
+	tree := &DependencyTree{Catalog: catalogRef}
+	tree.Root, err = r.resolve(root, nil, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+	tree.Unsatisfied = countUnsatisfied(tree.Root)
+
+	c.log.Debugf("Resolved dependencies of bundle %s with %d unsatisfied requirements.", root.Name, tree.Unsatisfied)
+	return tree, nil
+}
+
+// dependencyResolver indexes the packages of a catalog and their default channel heads.
+type dependencyResolver struct {
+	cfg      *declcfg.DeclarativeConfig
+	packages map[string]declcfg.Package
+	heads    map[string]*declcfg.Bundle
+}
+
+func newDependencyResolver(cfg *declcfg.DeclarativeConfig) *dependencyResolver {
+	r := &dependencyResolver{
+		cfg:      cfg,
+		packages: make(map[string]declcfg.Package),
+		heads:    make(map[string]*declcfg.Bundle),
+	}
+	bundles := bundlesByPackage(cfg)
+	for _, pkg := range cfg.Packages {
+		r.packages[pkg.Name] = pkg
+		r.heads[pkg.Name] = defaultChannelHead(cfg, pkg, bundles[pkg.Name])
+	}
+	return r
+}
+
+// resolve builds the node of a bundle and the subtrees of its requirements. ancestors holds
+// the bundles on the path from the root, to stop at cycles.
+func (r *dependencyResolver) resolve(b *declcfg.Bundle, requirement *Requirement, ancestors map[string]bool) (DependencyNode, error) {
+	node := DependencyNode{Requirement: requirement, Package: b.Package, Bundle: b.Name, Version: bundleVersion(b)}
+	if ancestors[b.Name] {
+		node.Cycle = true
+		return node, nil
+	}
+	ancestors[b.Name] = true
+	defer delete(ancestors, b.Name)
+
+	props, err := property.Parse(b.Properties)
+	if err != nil {
+		return DependencyNode{}, fmt.Errorf("failed to parse properties of bundle %q: %w", b.Name, err)
+	}
+
+	var requirements []Requirement
+	for _, req := range props.PackagesRequired {
+		requirements = append(requirements, Requirement{Type: RequirementPackage, Package: req.PackageName, VersionRange: req.VersionRange})
+	}
+	for _, req := range props.GVKsRequired {
+		gvk := property.GVK(req)
+		if providesGVK(props.GVKs, gvk) {
+			// A bundle requiring one of its own APIs needs nothing else.
+			continue
+		}
+		requirements = append(requirements, Requirement{Type: RequirementAPI, API: &gvk})
+	}
+
+	for _, req := range requirements {
+		candidate, reason := r.candidate(req)
+		if candidate == nil {
+			node.Dependencies = append(node.Dependencies, DependencyNode{Requirement: &req, Package: req.Package, Unsatisfied: reason})
+			continue
+		}
+		child, err := r.resolve(candidate, &req, ancestors)
+		if err != nil {
+			return DependencyNode{}, err
+		}
+		node.Dependencies = append(node.Dependencies, child)
+	}
+	return node, nil
+}
+
+// candidate returns the bundle chosen to satisfy a requirement, or the reason why there is none.
+func (r *dependencyResolver) candidate(req Requirement) (*declcfg.Bundle, string) {
+	if req.Type == RequirementAPI {
+		return r.apiCandidate(*req.API)
+	}
+	return r.packageCandidate(req.Package, req.VersionRange)
+}
+
+func (r *dependencyResolver) packageCandidate(pkgName, versionRange string) (*declcfg.Bundle, string) {
+	pkg, ok := r.packages[pkgName]
+	if !ok {
+		return nil, "package not found in catalog"
+	}
+	head := r.heads[pkgName]
+	if head == nil {
+		return nil, fmt.Sprintf("cannot determine the head of default channel %s", pkg.DefaultChannel)
+	}
+	if versionRange == "" {
+		return head, ""
+	}
+	inRange, err := semver.ParseRange(versionRange)
+	if err != nil {
+		return nil, fmt.Sprintf("invalid version range %q", versionRange)
+	}
+	if v, ok := parseVersion(bundleVersion(head)); ok && inRange(v) {
+		return head, ""
+	}
+
+	// Fall back to the latest bundle of the default channel within the range.
+	bundles := bundlesByName(r.cfg, pkgName)
+	var best *declcfg.Bundle
+	var bestVersion semver.Version
+	for _, ch := range r.cfg.Channels {
+		if ch.Package != pkgName || ch.Name != pkg.DefaultChannel {
+			continue
+		}
+		for _, entry := range ch.Entries {
+			b, ok := bundles[entry.Name]
+			if !ok {
+				continue
+			}
+			v, ok := parseVersion(bundleVersion(b))
+			if ok && inRange(v) && (best == nil || v.GT(bestVersion)) {
+				best, bestVersion = b, v
+			}
+		}
+	}
+	if best == nil {
+		return nil, fmt.Sprintf("no bundle of default channel %s in range %s", pkg.DefaultChannel, versionRange)
+	}
+	return best, ""
+}
+
+func (r *dependencyResolver) apiCandidate(gvk property.GVK) (*declcfg.Bundle, string) {
+	names := make([]string, 0, len(r.heads))
+	for name := range r.heads {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		head := r.heads[name]
+		if head == nil {
+			continue
+		}
+		props, err := property.Parse(head.Properties)
+		if err != nil {
+			continue
+		}
+		if providesGVK(props.GVKs, gvk) {
+			return head, ""
+		}
+	}
+	return nil, "no default channel head provides the API"
+}
+
+// providesGVK reports whether gvk is one of the provided APIs.
+func providesGVK(provided []property.GVK, gvk property.GVK) bool {
+	for _, p := range provided {
+		if p == gvk {
+			return true
+		}
+	}
+	return false
+}
+
+// countUnsatisfied returns the number of unsatisfied requirements in a subtree.
+func countUnsatisfied(node DependencyNode) int {
+	count := 0
+	if node.Unsatisfied != "" {
+		count++
+	}
+	for _, child := range node.Dependencies {
+		count += countUnsatisfied(child)
+	}
+	return count
+}
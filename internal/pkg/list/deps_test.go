@@ -0,0 +1,192 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// depsTestConfig returns a catalog where the app package requires the database package, the cache API
+// and an API no package provides. The database bundle requires the app package back.
+func depsTestConfig() *declcfg.DeclarativeConfig {
+	bundle := func(pkg, version string, props ...property.Property) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:       pkg + ".v" + version,
+			Package:    pkg,
+			Properties: append([]property.Property{property.MustBuildPackage(pkg, version)}, props...),
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Name: "app", DefaultChannel: "stable"},
+			{Name: "database", DefaultChannel: "stable"},
+			{Name: "cache", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "app", Entries: []declcfg.ChannelEntry{
+				{Name: "app.v0.9.0"},
+				{Name: "app.v1.0.0", Replaces: "app.v0.9.0"},
+			}},
+			{Name: "stable", Package: "database", Entries: []declcfg.ChannelEntry{
+				{Name: "database.v1.0.0"},
+				{Name: "database.v1.5.0", Replaces: "database.v1.0.0"},
+				{Name: "database.v2.0.0", Replaces: "database.v1.5.0"},
+			}},
+			{Name: "stable", Package: "cache", Entries: []declcfg.ChannelEntry{{Name: "cache.v3.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("app", "0.9.0", property.MustBuildPackageRequired("queue", "")),
+			bundle("app", "1.0.0",
+				property.MustBuildGVK("app.example.com", "v1", "App"),
+				property.MustBuildGVKRequired("app.example.com", "v1", "App"),
+				property.MustBuildPackageRequired("database", ">=1.0.0 <2.0.0"),
+				property.MustBuildGVKRequired("cache.example.com", "v1", "Cache"),
+				property.MustBuildGVKRequired("missing.example.com", "v1", "Missing"),
+			),
+			bundle("database", "1.0.0"),
+			bundle("database", "1.5.0", property.MustBuildPackageRequired("app", "")),
+			bundle("database", "2.0.0"),
+			bundle("cache", "3.0.0", property.MustBuildGVK("cache.example.com", "v1", "Cache")),
+		},
+	}
+}
+
+func TestDependencies(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		pkgName       string
+		bundleName    string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      *DependencyTree
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:    "Success Case - Default Channel Head",
+			pkgName: "app",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(depsTestConfig(), nil)
+			},
+			expected: &DependencyTree{
+				Catalog: "test-catalog:latest",
+				Root: DependencyNode{
+					Package: "app", Bundle: "app.v1.0.0", Version: "1.0.0",
+					Dependencies: []DependencyNode{
+						{
+							Requirement: &Requirement{Type: RequirementPackage, Package: "database", VersionRange: ">=1.0.0 <2.0.0"},
+							Package:     "database", Bundle: "database.v1.5.0", Version: "1.5.0",
+							Dependencies: []DependencyNode{
+								{Requirement: &Requirement{Type: RequirementPackage, Package: "app"}, Package: "app", Bundle: "app.v1.0.0", Version: "1.0.0", Cycle: true},
+							},
+						},
+						{
+							Requirement: &Requirement{Type: RequirementAPI, API: &property.GVK{Group: "cache.example.com", Version: "v1", Kind: "Cache"}},
+							Package:     "cache", Bundle: "cache.v3.0.0", Version: "3.0.0",
+						},
+						{
+							Requirement: &Requirement{Type: RequirementAPI, API: &property.GVK{Group: "missing.example.com", Version: "v1", Kind: "Missing"}},
+							Unsatisfied: "no default channel head provides the API",
+						},
+					},
+				},
+				Unsatisfied: 1,
+			},
+		},
+		{
+			name:       "Success Case - Given Bundle",
+			pkgName:    "app",
+			bundleName: "app.v0.9.0",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(depsTestConfig(), nil)
+			},
+			expected: &DependencyTree{
+				Catalog: "test-catalog:latest",
+				Root: DependencyNode{
+					Package: "app", Bundle: "app.v0.9.0", Version: "0.9.0",
+					Dependencies: []DependencyNode{
+						{Requirement: &Requirement{Type: RequirementPackage, Package: "queue"}, Package: "queue", Unsatisfied: "package not found in catalog"},
+					},
+				},
+				Unsatisfied: 1,
+			},
+		},
+		{
+			name:    "Success Case - No Requirements",
+			pkgName: "database",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(depsTestConfig(), nil)
+			},
+			expected: &DependencyTree{
+				Catalog: "test-catalog:latest",
+				Root:    DependencyNode{Package: "database", Bundle: "database.v2.0.0", Version: "2.0.0"},
+			},
+		},
+		{
+			name:    "Failure Case - Package Not Found",
+			pkgName: "non-existent",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(depsTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "non-existent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name:       "Failure Case - Bundle Not Found",
+			pkgName:    "app",
+			bundleName: "app.v9.9.9",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(depsTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `bundle "app.v9.9.9" not found in package "app"`,
+		},
+		{
+			name:    "Failure Case - Cataloger Error",
+			pkgName: "app",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Dependencies("test-catalog:latest", tc.pkgName, tc.bundleName)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestRequirement_String(t *testing.T) {
+	assert.Equal(t, "package etcd >=0.9.0", Requirement{Type: RequirementPackage, Package: "etcd", VersionRange: ">=0.9.0"}.String())
+	assert.Equal(t, "package etcd", Requirement{Type: RequirementPackage, Package: "etcd"}.String())
+	assert.Equal(t, "api EtcdCluster.etcd.database.coreos.com/v1beta2",
+		Requirement{Type: RequirementAPI, API: &property.GVK{Group: "etcd.database.coreos.com", Version: "v1beta2", Kind: "EtcdCluster"}}.String())
+}
@@ -0,0 +1,182 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// CatalogDiff lists the changes between two catalogs.
+type CatalogDiff struct {
+	From            string        `json:"from"`
+	To              string        `json:"to"`
+	AddedPackages   []string      `json:"addedPackages,omitempty"`
+	RemovedPackages []string      `json:"removedPackages,omitempty"`
+	ChangedPackages []PackageDiff `json:"changedPackages,omitempty"`
+}
+
+// PackageDiff lists the changes of a package present in both catalogs. The default
+// channel fields are only set when the default channel changed.
+type PackageDiff struct {
+	Name              string       `json:"name"`
+	OldDefaultChannel string       `json:"oldDefaultChannel,omitempty"`
+	NewDefaultChannel string       `json:"newDefaultChannel,omitempty"`
+	AddedChannels     []string     `json:"addedChannels,omitempty"`
+	RemovedChannels   []string     `json:"removedChannels,omitempty"`
+	AddedBundles      []string     `json:"addedBundles,omitempty"`
+	RemovedBundles    []string     `json:"removedBundles,omitempty"`
+	ChangedHeads      []HeadChange `json:"changedHeads,omitempty"`
+}
+
+// HeadChange is a channel whose head moved between two catalogs.
+type HeadChange struct {
+	Channel string `json:"channel"`
+	From    string `json:"from"`
+	To      string `json:"to"`
+}
+
+// IsEmpty reports whether the catalogs have no differences.
+func (d *CatalogDiff) IsEmpty() bool {
+	return len(d.AddedPackages) == 0 && len(d.RemovedPackages) == 0 && len(d.ChangedPackages) == 0
+}
+
+// packageSnapshot is the state of a package in a catalog used for comparisons.
+type packageSnapshot struct {
+	defaultChannel string
+	// heads maps every channel name to its comma separated heads.
+	heads   map[string]string
+	bundles []string
+}
+
+// Diff compares the catalog toRef against fromRef. When packages is not empty, only
+// those packages are compared.
+func (c *CatalogLister) Diff(fromRef, toRef string, packages []string) (*CatalogDiff, error) {
+	if fromRef == "" || toRef == "" {
+		return nil, fmt.Errorf("both catalog references are required")
+	}
+	c.log.Debugf("Comparing catalog %s to %s...", toRef, fromRef)
+	fromCfg, err := c.cataloger.CatalogConfig(fromRef)
+	if err != nil {
+		return nil, err
+	}
+	toCfg, err := c.cataloger.CatalogConfig(toRef)
+	if err != nil {
+		return nil, err
+	}
+
+	before := packageSnapshots(fromCfg)
+	after := packageSnapshots(toCfg)
+	for _, name := range packages {
+		_, inFrom := before[name]
+		_, inTo := after[name]
+		if !inFrom && !inTo {
+			return nil, fmt.Errorf("package %q not found in either catalog", name)
+		}
+	}
+	selected := func(name string) bool { return len(packages) == 0 || slices.Contains(packages, name) }
+
+	diff := &CatalogDiff{From: fromRef, To: toRef}
+	for _, name := range sortedKeys(after) {
+		if _, ok := before[name]; !ok && selected(name) {
+			diff.AddedPackages = append(diff.AddedPackages, name)
+		}
+	}
+	for _, name := range sortedKeys(before) {
+		if !selected(name) {
+			continue
+		}
+		newPkg, ok := after[name]
+		if !ok {
+			diff.RemovedPackages = append(diff.RemovedPackages, name)
+			continue
+		}
+		if pkgDiff := diffPackage(name, before[name], newPkg); pkgDiff != nil {
+			diff.ChangedPackages = append(diff.ChangedPackages, *pkgDiff)
+		}
+	}
+
+	c.log.Debugf("Found %d added, %d removed and %d changed packages.", len(diff.AddedPackages), len(diff.RemovedPackages), len(diff.ChangedPackages))
+	return diff, nil
+}
+
+// diffPackage compares two snapshots of a package, returning nil when nothing changed.
+func diffPackage(name string, before, after packageSnapshot) *PackageDiff {
+	d := PackageDiff{
+		Name:            name,
+		AddedChannels:   missingFrom(sortedKeys(after.heads), before.heads),
+		RemovedChannels: missingFrom(sortedKeys(before.heads), after.heads),
+		AddedBundles:    subtract(after.bundles, before.bundles),
+		RemovedBundles:  subtract(before.bundles, after.bundles),
+	}
+	if before.defaultChannel != after.defaultChannel {
+		d.OldDefaultChannel = before.defaultChannel
+		d.NewDefaultChannel = after.defaultChannel
+	}
+	for _, ch := range sortedKeys(before.heads) {
+		if newHead, ok := after.heads[ch]; ok && newHead != before.heads[ch] {
+			d.ChangedHeads = append(d.ChangedHeads, HeadChange{Channel: ch, From: before.heads[ch], To: newHead})
+		}
+	}
+
+	if d.OldDefaultChannel == "" && d.NewDefaultChannel == "" && len(d.AddedChannels) == 0 && len(d.RemovedChannels) == 0 &&
+		len(d.AddedBundles) == 0 && len(d.RemovedBundles) == 0 && len(d.ChangedHeads) == 0 {
+		return nil
+	}
+	return &d
+}
+
+func packageSnapshots(cfg *declcfg.DeclarativeConfig) map[string]packageSnapshot {
+	snapshots := make(map[string]packageSnapshot, len(cfg.Packages))
+	for _, pkg := range cfg.Packages {
+		snapshots[pkg.Name] = packageSnapshot{defaultChannel: pkg.DefaultChannel, heads: make(map[string]string)}
+	}
+	for _, ch := range cfg.Channels {
+		if s, ok := snapshots[ch.Package]; ok {
+			heads, _ := ChannelHeads(ch)
+			s.heads[ch.Name] = strings.Join(heads, ",")
+		}
+	}
+	for _, b := range cfg.Bundles {
+		if s, ok := snapshots[b.Package]; ok {
+			s.bundles = append(s.bundles, b.Name)
+			snapshots[b.Package] = s
+		}
+	}
+	return snapshots
+}
+
+// sortedKeys returns the keys of a map in lexical order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// missingFrom returns the names that are not keys of m.
+func missingFrom(names []string, m map[string]string) []string {
+	var missing []string
+	for _, name := range names {
+		if _, ok := m[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	return missing
+}
+
+// subtract returns the sorted values of a that are not in b.
+func subtract(a, b []string) []string {
+	var result []string
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			result = append(result, v)
+		}
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,129 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// diffTestConfigs returns the graph test catalog and a later version of it: the fast channel is the new
+// default and gained a bundle, the stable channel lost its oldest entry, a beta channel and
+// a new package were added and pkg2 was removed.
+func diffTestConfigs() (*declcfg.DeclarativeConfig, *declcfg.DeclarativeConfig) {
+	from := graphTestConfig()
+	from.Packages = append(from.Packages, declcfg.Package{Name: "pkg2", DefaultChannel: "stable"})
+
+	to := graphTestConfig()
+	to.Packages[0].DefaultChannel = "fast"
+	to.Packages = append(to.Packages, declcfg.Package{Name: "pkg3", DefaultChannel: "stable"})
+	to.Channels[0].Entries = to.Channels[0].Entries[1:]
+	to.Channels[1].Entries = append(to.Channels[1].Entries, declcfg.ChannelEntry{Name: "pkg1.v1.3.0", Replaces: "pkg1.v1.2.0"})
+	to.Channels = append(to.Channels, declcfg.Channel{Name: "beta", Package: "pkg1", Entries: []declcfg.ChannelEntry{{Name: "pkg1.v1.3.0"}}})
+	to.Bundles = append(to.Bundles[1:], declcfg.Bundle{Name: "pkg1.v1.3.0", Package: "pkg1"})
+	return from, to
+}
+
+func TestDiff(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	pkg1Diff := PackageDiff{
+		Name:              "pkg1",
+		OldDefaultChannel: "stable",
+		NewDefaultChannel: "fast",
+		AddedChannels:     []string{"beta"},
+		AddedBundles:      []string{"pkg1.v1.3.0"},
+		RemovedBundles:    []string{"pkg1.v1.0.0"},
+		ChangedHeads:      []HeadChange{{Channel: "fast", From: "pkg1.v1.2.0", To: "pkg1.v1.3.0"}},
+	}
+
+	testCases := []struct {
+		name          string
+		packages      []string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      *CatalogDiff
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name: "Success Case - All Packages",
+			setupMocks: func(m *mock.MockCataloger) {
+				from, to := diffTestConfigs()
+				m.EXPECT().CatalogConfig("catalog:v1").Return(from, nil)
+				m.EXPECT().CatalogConfig("catalog:v2").Return(to, nil)
+			},
+			expected: &CatalogDiff{
+				From:            "catalog:v1",
+				To:              "catalog:v2",
+				AddedPackages:   []string{"pkg3"},
+				RemovedPackages: []string{"pkg2"},
+				ChangedPackages: []PackageDiff{pkg1Diff},
+			},
+		},
+		{
+			name:     "Success Case - Filtered Packages",
+			packages: []string{"pkg2"},
+			setupMocks: func(m *mock.MockCataloger) {
+				from, to := diffTestConfigs()
+				m.EXPECT().CatalogConfig("catalog:v1").Return(from, nil)
+				m.EXPECT().CatalogConfig("catalog:v2").Return(to, nil)
+			},
+			expected: &CatalogDiff{From: "catalog:v1", To: "catalog:v2", RemovedPackages: []string{"pkg2"}},
+		},
+		{
+			name: "Success Case - No Differences",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("catalog:v1").Return(graphTestConfig(), nil)
+				m.EXPECT().CatalogConfig("catalog:v2").Return(graphTestConfig(), nil)
+			},
+			expected: &CatalogDiff{From: "catalog:v1", To: "catalog:v2"},
+		},
+		{
+			name:     "Failure Case - Package Not Found",
+			packages: []string{"nonexistent"},
+			setupMocks: func(m *mock.MockCataloger) {
+				from, to := diffTestConfigs()
+				m.EXPECT().CatalogConfig("catalog:v1").Return(from, nil)
+				m.EXPECT().CatalogConfig("catalog:v2").Return(to, nil)
+			},
+			expectErr:     true,
+			expectedError: `package "nonexistent" not found in either catalog`,
+		},
+		{
+			name: "Failure Case - CatalogConfig returns error",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("catalog:v1").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Diff("catalog:v1", "catalog:v2", tc.packages)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,372 @@
+package list
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// PackageFilter selects a package, optionally narrowed to a channel and to a range of bundle
+// versions in that channel. Empty fields do not filter.
+type PackageFilter struct {
+	Package    string `json:"package"`
+	Channel    string `json:"channel,omitempty"`
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// ParsePackageFilter parses a package[:channel[@min-max]] filter. Either bound of the range may be
+// omitted, as in @1.2.0- or @-2.0.0, and a single version such as @1.2.0 selects that version only.
+func ParsePackageFilter(spec string) (PackageFilter, error) {
+	invalid := fmt.Errorf("invalid package filter %q, expected package[:channel[@min-max]]", spec)
+	var filter PackageFilter
+	pkg, channel, hasChannel := strings.Cut(spec, ":")
+	filter.Package = pkg
+	if filter.Package == "" || (hasChannel && channel == "") {
+		return PackageFilter{}, invalid
+	}
+	if !hasChannel {
+		return filter, nil
+	}
+
+	channel, versions, hasVersions := strings.Cut(channel, "@")
+	filter.Channel = channel
+	if filter.Channel == "" || (hasVersions && versions == "") {
+		return PackageFilter{}, invalid
+	}
+	if !hasVersions {
+		return filter, nil
+	}
+
+	valid := func(v string) bool {
+		_, ok := parseVersion(v)
+		return v == "" || ok
+	}
+	// Versions may hold prerelease dashes, so the range is split at the first dash leaving a
+	// valid version, or no version, on both sides.
+	for i := range versions {
+		if versions[i] != '-' {
+			continue
+		}
+		lower, upper := versions[:i], versions[i+1:]
+		if (lower != "" || upper != "") && valid(lower) && valid(upper) {
+			filter.MinVersion, filter.MaxVersion = lower, upper
+			return filter, nil
+		}
+	}
+	if _, ok := parseVersion(versions); ok {
+		filter.MinVersion, filter.MaxVersion = versions, versions
+		return filter, nil
+	}
+	return PackageFilter{}, fmt.Errorf("invalid version range %q in package filter %q, expected min-max", versions, spec)
+}
+
+// String returns the filter in the package[:channel[@min-max]] form.
+func (f PackageFilter) String() string {
+	s := f.Package
+	if f.Channel != "" {
+		s += ":" + f.Channel
+	}
+	if f.MinVersion != "" || f.MaxVersion != "" {
+		s += "@" + f.MinVersion + "-" + f.MaxVersion
+	}
+	return s
+}
+
+// FilteredCatalog summarizes the FBC written by Filter.
+type FilteredCatalog struct {
+	Catalog   string            `json:"catalog"`
+	OutputDir string            `json:"outputDir"`
+	Packages  []FilteredPackage `json:"packages"`
+}
+
+// FilteredPackage is a package kept by Filter, with its kept channels and number of bundles.
+// PreviousDefaultChannel is set when the default channel was filtered out and replaced.
+type FilteredPackage struct {
+	Name                   string   `json:"name"`
+	DefaultChannel         string   `json:"defaultChannel"`
+	PreviousDefaultChannel string   `json:"previousDefaultChannel,omitempty"`
+	Channels               []string `json:"channels"`
+	Bundles                int      `json:"bundles"`
+}
+
+// Filter writes the subset of a catalog selected by filters to outputDir as a file-based catalog,
+// with one <package>/catalog.json file per package. Filters on the same package add up. Channels
+// are trimmed to the kept bundles, with their replaces chains reconnected over the removed ones,
+// the default channel is replaced when it was filtered out, and the deprecations of the kept
+// packages, channels and bundles are retained. The result is validated before it is written.
+func (c *CatalogLister) Filter(catalogRef string, filters []PackageFilter, outputDir string) (*FilteredCatalog, error) {
+	if catalogRef == "" || outputDir == "" {
+		return nil, fmt.Errorf("catalog reference and output directory are required")
+	}
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("at least one package filter is required")
+	}
+	if entries, err := os.ReadDir(outputDir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("output directory %q is not empty", outputDir)
+	}
+	c.log.Debugf("Filtering catalog %s...", catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	filtered, summary, err := c.filterConfig(cfg, filters)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := declcfg.ConvertToModel(*filtered); err != nil {
+		return nil, fmt.Errorf("filtered catalog is not valid: %w", err)
+	}
+	if err := writePackageFiles(filtered, outputDir); err != nil {
+		return nil, fmt.Errorf("failed to write filtered catalog to %q: %w", outputDir, err)
+	}
+
+	c.log.Debugf("Wrote %d packages to %s", len(summary), outputDir)
+	return &FilteredCatalog{Catalog: catalogRef, OutputDir: outputDir, Packages: summary}, nil
+}
+
+// filterConfig returns the subset of cfg selected by filters and a summary of its packages.
+func (c *CatalogLister) filterConfig(cfg *declcfg.DeclarativeConfig, filters []PackageFilter) (*declcfg.DeclarativeConfig, []FilteredPackage, error) {
+	// keptChannels maps packages to their kept channels and the kept entries of each channel,
+	// where a nil entry set keeps the whole channel.
+	keptChannels := make(map[string]map[string]map[string]bool)
+	for _, filter := range filters {
+		if !packageExists(cfg, filter.Package) {
+			return nil, nil, fmt.Errorf("package %q not found in catalog", filter.Package)
+		}
+		inRange, err := versionFilter(filter.MinVersion, filter.MaxVersion)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid package filter %q: %w", filter, err)
+		}
+		bundles := bundlesByName(cfg, filter.Package)
+		channels := keptChannels[filter.Package]
+		if channels == nil {
+			channels = make(map[string]map[string]bool)
+			keptChannels[filter.Package] = channels
+		}
+
+		found := false
+		for _, ch := range cfg.Channels {
+			if ch.Package != filter.Package || (filter.Channel != "" && ch.Name != filter.Channel) {
+				continue
+			}
+			found = true
+			if filter.Channel == "" || (filter.MinVersion == "" && filter.MaxVersion == "") {
+				channels[ch.Name] = nil
+				continue
+			}
+			entries, kept := channels[ch.Name]
+			if kept && entries == nil {
+				// The whole channel is already kept by another filter.
+				continue
+			}
+			if entries == nil {
+				entries = make(map[string]bool)
+			}
+			for _, entry := range ch.Entries {
+				if inRange(bundleVersion(bundles[entry.Name])) {
+					entries[entry.Name] = true
+				}
+			}
+			if len(entries) == 0 {
+				return nil, nil, fmt.Errorf("no bundles of channel %q of package %q match %q", ch.Name, filter.Package, filter)
+			}
+			channels[ch.Name] = entries
+		}
+		if filter.Channel != "" && !found {
+			return nil, nil, fmt.Errorf("channel %q for package %q not found", filter.Channel, filter.Package)
+		}
+	}
+
+	filtered := &declcfg.DeclarativeConfig{}
+	keptBundles := make(map[string]map[string]bool)
+	for _, ch := range cfg.Channels {
+		entries, ok := keptChannels[ch.Package][ch.Name]
+		if !ok {
+			continue
+		}
+		trimmed := trimChannel(ch, entries)
+		filtered.Channels = append(filtered.Channels, trimmed)
+		if keptBundles[ch.Package] == nil {
+			keptBundles[ch.Package] = make(map[string]bool)
+		}
+		for _, entry := range trimmed.Entries {
+			keptBundles[ch.Package][entry.Name] = true
+		}
+	}
+	for _, b := range cfg.Bundles {
+		if keptBundles[b.Package][b.Name] {
+			filtered.Bundles = append(filtered.Bundles, b)
+		}
+	}
+
+	var summary []FilteredPackage
+	for _, pkg := range cfg.Packages {
+		channels, ok := keptChannels[pkg.Name]
+		if !ok {
+			continue
+		}
+		pkgSummary := FilteredPackage{Name: pkg.Name, DefaultChannel: pkg.DefaultChannel, Bundles: len(keptBundles[pkg.Name])}
+		for name := range channels {
+			pkgSummary.Channels = append(pkgSummary.Channels, name)
+		}
+		sort.Strings(pkgSummary.Channels)
+		if _, ok := channels[pkg.DefaultChannel]; !ok {
+			pkg.DefaultChannel = newestChannel(filtered, pkg.Name, bundlesByName(cfg, pkg.Name))
+			pkgSummary.PreviousDefaultChannel = pkgSummary.DefaultChannel
+			pkgSummary.DefaultChannel = pkg.DefaultChannel
+			c.log.Warnf("Default channel %s of package %s was filtered out, using %s instead", pkgSummary.PreviousDefaultChannel, pkg.Name, pkg.DefaultChannel)
+		}
+		filtered.Packages = append(filtered.Packages, pkg)
+		summary = append(summary, pkgSummary)
+	}
+
+	for _, d := range cfg.Deprecations {
+		channels, ok := keptChannels[d.Package]
+		if !ok {
+			continue
+		}
+		kept := d
+		kept.Entries = slices.DeleteFunc(slices.Clone(d.Entries), func(entry declcfg.DeprecationEntry) bool {
+			switch entry.Reference.Schema {
+			case declcfg.SchemaChannel:
+				_, ok := channels[entry.Reference.Name]
+				return !ok
+			case declcfg.SchemaBundle:
+				return !keptBundles[d.Package][entry.Reference.Name]
+			}
+			return false
+		})
+		if len(kept.Entries) > 0 {
+			filtered.Deprecations = append(filtered.Deprecations, kept)
+		}
+	}
+	for _, o := range cfg.Others {
+		if _, ok := keptChannels[o.Package]; ok {
+			filtered.Others = append(filtered.Others, o)
+		}
+	}
+	return filtered, summary, nil
+}
+
+// trimChannel returns ch with only the entries in kept, or ch itself when kept is nil. A kept
+// entry replacing a removed one is reconnected to the closest kept entry down its replaces chain,
+// so that the channel keeps a single head, and skips the removed one so that clusters running it
+// can still upgrade. An entry with no kept ancestor keeps its replaces, as the tail of a channel
+// may replace a bundle that is not in it.
+func trimChannel(ch declcfg.Channel, kept map[string]bool) declcfg.Channel {
+	if kept == nil {
+		return ch
+	}
+	replaces := make(map[string]string, len(ch.Entries))
+	for _, entry := range ch.Entries {
+		replaces[entry.Name] = entry.Replaces
+	}
+
+	trimmed := ch
+	trimmed.Entries = nil
+	for _, entry := range ch.Entries {
+		if !kept[entry.Name] {
+			continue
+		}
+		seen := map[string]bool{entry.Name: true}
+		for r := entry.Replaces; r != "" && !seen[r]; r = replaces[r] {
+			seen[r] = true
+			if kept[r] {
+				if r != entry.Replaces {
+					entry.Skips = append(slices.Clone(entry.Skips), entry.Replaces)
+				}
+				entry.Replaces = r
+				break
+			}
+		}
+		trimmed.Entries = append(trimmed.Entries, entry)
+	}
+	return trimmed
+}
+
+// newestChannel returns the channel of a package whose head has the highest version, preferring
+// the first channel by name on ties.
+func newestChannel(cfg *declcfg.DeclarativeConfig, pkgName string, bundles map[string]*declcfg.Bundle) string {
+	var best, bestVersion string
+	for _, ch := range cfg.Channels {
+		if ch.Package != pkgName {
+			continue
+		}
+		heads, _ := ChannelHeads(ch)
+		version := ""
+		if len(heads) > 0 {
+			version = bundleVersion(bundles[heads[0]])
+		}
+		if best == "" || compareVersions(version, bestVersion) > 0 || (compareVersions(version, bestVersion) == 0 && ch.Name < best) {
+			best, bestVersion = ch.Name, version
+		}
+	}
+	return best
+}
+
+// compareVersions compares two bundle versions, ordering unparsable versions first.
+func compareVersions(a, b string) int {
+	va, okA := parseVersion(a)
+	vb, okB := parseVersion(b)
+	switch {
+	case okA && okB:
+		return va.Compare(vb)
+	case okA:
+		return 1
+	case okB:
+		return -1
+	}
+	return 0
+}
+
+// writePackageFiles writes each package of cfg to <dir>/<package>/catalog.json. declcfg.WriteFS
+// is not used as it drops the deprecations and other blobs.
+func writePackageFiles(cfg *declcfg.DeclarativeConfig, dir string) error {
+	for _, pkg := range cfg.Packages {
+		pkgCfg := declcfg.DeclarativeConfig{Packages: []declcfg.Package{pkg}}
+		for _, ch := range cfg.Channels {
+			if ch.Package == pkg.Name {
+				pkgCfg.Channels = append(pkgCfg.Channels, ch)
+			}
+		}
+		for _, b := range cfg.Bundles {
+			if b.Package == pkg.Name {
+				pkgCfg.Bundles = append(pkgCfg.Bundles, b)
+			}
+		}
+		for _, d := range cfg.Deprecations {
+			if d.Package == pkg.Name {
+				pkgCfg.Deprecations = append(pkgCfg.Deprecations, d)
+			}
+		}
+		for _, o := range cfg.Others {
+			if o.Package == pkg.Name {
+				pkgCfg.Others = append(pkgCfg.Others, o)
+			}
+		}
+
+		pkgDir := filepath.Join(dir, pkg.Name)
+		if err := os.MkdirAll(pkgDir, 0755); err != nil {
+			return err
+		}
+		f, err := os.Create(filepath.Join(pkgDir, "catalog.json"))
+		if err != nil {
+			return err
+		}
+		if err := declcfg.WriteJSON(pkgCfg, f); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
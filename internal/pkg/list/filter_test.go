@@ -0,0 +1,226 @@
+package list
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParsePackageFilter(t *testing.T) {
+	testCases := []struct {
+		spec          string
+		expected      PackageFilter
+		expectedError string
+	}{
+		{spec: "logging", expected: PackageFilter{Package: "logging"}},
+		{spec: "logging:stable-5.8", expected: PackageFilter{Package: "logging", Channel: "stable-5.8"}},
+		{spec: "logging:stable-5.8@5.8.1-5.8.3", expected: PackageFilter{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.1", MaxVersion: "5.8.3"}},
+		{spec: "logging:stable@1.0.0-rc.1-2.0.0", expected: PackageFilter{Package: "logging", Channel: "stable", MinVersion: "1.0.0-rc.1", MaxVersion: "2.0.0"}},
+		{spec: "logging:stable@5.8.1-", expected: PackageFilter{Package: "logging", Channel: "stable", MinVersion: "5.8.1"}},
+		{spec: "logging:stable@-5.8.1", expected: PackageFilter{Package: "logging", Channel: "stable", MaxVersion: "5.8.1"}},
+		{spec: "logging:stable@5.8.1", expected: PackageFilter{Package: "logging", Channel: "stable", MinVersion: "5.8.1", MaxVersion: "5.8.1"}},
+		{spec: ":stable", expectedError: `invalid package filter ":stable", expected package[:channel[@min-max]]`},
+		{spec: "logging:", expectedError: `invalid package filter "logging:", expected package[:channel[@min-max]]`},
+		{spec: "logging:stable@", expectedError: `invalid package filter "logging:stable@", expected package[:channel[@min-max]]`},
+		{spec: "logging:stable@latest", expectedError: `invalid version range "latest" in package filter "logging:stable@latest", expected min-max`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			filter, err := ParsePackageFilter(tc.spec)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, filter)
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	catalogRef := "registry.example.com/catalog:latest"
+	bundle := func(pkg, version string) declcfg.Bundle {
+		return declcfg.Bundle{
+			Schema:     declcfg.SchemaBundle,
+			Name:       pkg + ".v" + version,
+			Package:    pkg,
+			Image:      "registry.example.com/" + pkg + "-bundle:v" + version,
+			Properties: []property.Property{property.MustBuildPackage(pkg, version)},
+		}
+	}
+	cfg := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Schema: declcfg.SchemaPackage, Name: "logging", DefaultChannel: "stable-6.0"},
+			{Schema: declcfg.SchemaPackage, Name: "jaeger", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Schema: declcfg.SchemaChannel, Name: "stable-5.8", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v5.8.0"},
+				{Name: "logging.v5.8.1", Replaces: "logging.v5.8.0"},
+				{Name: "logging.v5.8.2", Replaces: "logging.v5.8.1"},
+			}},
+			{Schema: declcfg.SchemaChannel, Name: "stable-6.0", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v6.0.0"},
+			}},
+			{Schema: declcfg.SchemaChannel, Name: "stable", Package: "jaeger", Entries: []declcfg.ChannelEntry{
+				{Name: "jaeger.v1.0.0"},
+			}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("logging", "5.8.0"), bundle("logging", "5.8.1"), bundle("logging", "5.8.2"),
+			bundle("logging", "6.0.0"), bundle("jaeger", "1.0.0"),
+		},
+		Deprecations: []declcfg.Deprecation{{
+			Schema:  declcfg.SchemaDeprecation,
+			Package: "logging",
+			Entries: []declcfg.DeprecationEntry{
+				{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaChannel, Name: "stable-5.8"}, Message: "use stable-6.0"},
+				{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaChannel, Name: "stable-6.0"}, Message: "use stable-6.1"},
+				{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaBundle, Name: "logging.v5.8.0"}, Message: "CVE"},
+			},
+		}},
+	}
+
+	testCases := []struct {
+		name                 string
+		filters              []PackageFilter
+		setupMocks           func(c *mock.MockCataloger)
+		expected             []FilteredPackage
+		expectedChannels     []declcfg.Channel
+		expectedDeprecations []declcfg.DeprecationEntry
+		expectErr            bool
+		expectedError        string
+	}{
+		{
+			name:    "Success Case - Version Range Moves Default Channel",
+			filters: []PackageFilter{{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.1", MaxVersion: "5.8.2"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []FilteredPackage{
+				{Name: "logging", DefaultChannel: "stable-5.8", PreviousDefaultChannel: "stable-6.0", Channels: []string{"stable-5.8"}, Bundles: 2},
+			},
+			expectedChannels: []declcfg.Channel{
+				{Schema: declcfg.SchemaChannel, Name: "stable-5.8", Package: "logging", Entries: []declcfg.ChannelEntry{
+					{Name: "logging.v5.8.1", Replaces: "logging.v5.8.0"},
+					{Name: "logging.v5.8.2", Replaces: "logging.v5.8.1"},
+				}},
+			},
+			expectedDeprecations: []declcfg.DeprecationEntry{
+				{Reference: declcfg.PackageScopedReference{Schema: declcfg.SchemaChannel, Name: "stable-5.8"}, Message: "use stable-6.0"},
+			},
+		},
+		{
+			name: "Success Case - Removed Bundle Is Skipped",
+			filters: []PackageFilter{
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.0", MaxVersion: "5.8.0"},
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.2", MaxVersion: "5.8.2"},
+				{Package: "logging", Channel: "stable-6.0"},
+			},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []FilteredPackage{
+				{Name: "logging", DefaultChannel: "stable-6.0", Channels: []string{"stable-5.8", "stable-6.0"}, Bundles: 3},
+			},
+			expectedChannels: []declcfg.Channel{
+				{Schema: declcfg.SchemaChannel, Name: "stable-5.8", Package: "logging", Entries: []declcfg.ChannelEntry{
+					{Name: "logging.v5.8.0"},
+					{Name: "logging.v5.8.2", Replaces: "logging.v5.8.0", Skips: []string{"logging.v5.8.1"}},
+				}},
+				{Schema: declcfg.SchemaChannel, Name: "stable-6.0", Package: "logging", Entries: []declcfg.ChannelEntry{
+					{Name: "logging.v6.0.0"},
+				}},
+			},
+			expectedDeprecations: cfg.Deprecations[0].Entries,
+		},
+		{
+			name:    "Failure Case - Empty Version Range",
+			filters: []PackageFilter{{Package: "logging", Channel: "stable-5.8", MinVersion: "6.0.0"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `no bundles of channel "stable-5.8" of package "logging" match "logging:stable-5.8@6.0.0-"`,
+		},
+		{
+			name:    "Failure Case - Channel Not Found",
+			filters: []PackageFilter{{Package: "logging", Channel: "fast"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "fast" for package "logging" not found`,
+		},
+		{
+			name:    "Failure Case - Package Not Found",
+			filters: []PackageFilter{{Package: "missing"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `package "missing" not found in catalog`,
+		},
+		{
+			name:          "Failure Case - No Filters",
+			setupMocks:    func(c *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "at least one package filter is required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mock.NewMockImager(mockCtrl))
+			outputDir := filepath.Join(t.TempDir(), "out")
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Filter(catalogRef, tc.filters, outputDir)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.NoDirExists(t, outputDir)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, &FilteredCatalog{Catalog: catalogRef, OutputDir: outputDir, Packages: tc.expected}, result)
+
+			assert.FileExists(t, filepath.Join(outputDir, "logging", "catalog.json"))
+			written, err := declcfg.LoadFS(context.Background(), os.DirFS(outputDir))
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectedChannels, written.Channels)
+			require.Len(t, written.Deprecations, 1)
+			assert.Equal(t, tc.expectedDeprecations, written.Deprecations[0].Entries)
+			require.Len(t, written.Packages, 1)
+			assert.Equal(t, tc.expected[0].DefaultChannel, written.Packages[0].DefaultChannel)
+			assert.Len(t, written.Bundles, tc.expected[0].Bundles)
+		})
+	}
+
+	t.Run("Failure Case - Output Directory Not Empty", func(t *testing.T) {
+		outputDir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(outputDir, "catalog.json"), []byte("{}"), 0644))
+		lister := NewCatalogLister(logger, mock.NewMockCataloger(mockCtrl), mock.NewMockImager(mockCtrl))
+
+		_, err := lister.Filter(catalogRef, []PackageFilter{{Package: "logging"}}, outputDir)
+		assert.EqualError(t, err, `output directory "`+outputDir+`" is not empty`)
+	})
+}
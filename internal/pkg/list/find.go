@@ -0,0 +1,163 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// Relations between a bundle and an API, named after the bundle property declaring them.
+const (
+	RelationProvides = "provides"
+	RelationRequires = "requires"
+)
+
+// APIMatch is a bundle that provides or requires an API.
+type APIMatch struct {
+	Catalog       string       `json:"catalog"`
+	Package       string       `json:"package"`
+	Bundle        string       `json:"bundle"`
+	BundleVersion string       `json:"bundleVersion,omitempty"`
+	Channels      []string     `json:"channels,omitempty"`
+	Relation      string       `json:"relation"`
+	API           property.GVK `json:"api"`
+}
+
+// ParseGVK parses an API given as group/version/kind, e.g. kafka.strimzi.io/v1beta2/KafkaTopic,
+// as Kind.group/version, e.g. KafkaTopic.kafka.strimzi.io/v1beta2, as version/Kind, e.g.
+// v1/ConfigMap, or as a bare Kind. Fields left out match any value. The Kind of the two-part forms
+// must be capitalized, so that a group/version such as apps/v1 is rejected.
+func ParseGVK(s string) (property.GVK, error) {
+	parts := strings.Split(s, "/")
+	var gvk property.GVK
+	switch len(parts) {
+	case 1:
+		gvk.Kind = parts[0]
+	case 2:
+		// Kinds are capitalized and versions are not, which tells v1/ConfigMap from Kind.group/version.
+		if isCapitalized(parts[1]) {
+			gvk = property.GVK{Version: parts[0], Kind: parts[1]}
+			break
+		}
+		kind, group, _ := strings.Cut(parts[0], ".")
+		if isCapitalized(kind) {
+			gvk = property.GVK{Group: group, Version: parts[1], Kind: kind}
+		}
+	case 3:
+		gvk = property.GVK{Group: parts[0], Version: parts[1], Kind: parts[2]}
+	}
+	if gvk.Kind == "" {
+		return property.GVK{}, fmt.Errorf("invalid API %q, expected group/version/Kind, Kind.group/version or version/Kind", s)
+	}
+	return gvk, nil
+}
+
+// isCapitalized reports whether s starts with an upper-case letter, as Kinds do.
+func isCapitalized(s string) bool {
+	r, _ := utf8.DecodeRuneInString(s)
+	return unicode.IsUpper(r)
+}
+
+// FormatGVK formats an API as Kind.group/version, the form accepted by ParseGVK, leaving
+// out the group of core APIs.
+func FormatGVK(gvk property.GVK) string {
+	if gvk.Group == "" {
+		return gvk.Kind + "/" + gvk.Version
+	}
+	return gvk.Kind + "." + gvk.Group + "/" + gvk.Version
+}
+
+// FindAPI looks for the bundles that provide or require an API in the given catalogs, by
+// reading their olm.gvk or olm.gvk.required properties. relation is RelationProvides or
+// RelationRequires and api is parsed with ParseGVK.
+func (c *CatalogLister) FindAPI(catalogRefs []string, api, relation string) ([]APIMatch, error) {
+	query, err := ParseGVK(api)
+	if err != nil {
+		return nil, err
+	}
+	if relation != RelationProvides && relation != RelationRequires {
+		return nil, fmt.Errorf("unknown relation %q, expected %s or %s", relation, RelationProvides, RelationRequires)
+	}
+	c.log.Debugf("Finding bundles that %s %s in %d catalogs...", relation, api, len(catalogRefs))
+	catalogs, err := c.loadCatalogs(catalogRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []APIMatch
+	for _, catalog := range catalogs {
+		matches = append(matches, c.findAPI(catalog.cfg, catalog.ref, query, relation)...)
+	}
+
+	c.log.Debugf("Found %d matching bundles.", len(matches))
+	return matches, nil
+}
+
+// findAPI returns the bundles of a catalog that provide or require the queried API,
+// sorted by package and bundle version. Bundles with malformed properties are skipped.
+func (c *CatalogLister) findAPI(cfg *declcfg.DeclarativeConfig, catalogRef string, query property.GVK, relation string) []APIMatch {
+	channels := make(map[string][]string)
+	for _, ch := range cfg.Channels {
+		for _, entry := range ch.Entries {
+			key := ch.Package + "/" + entry.Name
+			channels[key] = append(channels[key], ch.Name)
+		}
+	}
+
+	var matches []APIMatch
+	for i := range cfg.Bundles {
+		b := &cfg.Bundles[i]
+		props, err := property.Parse(b.Properties)
+		if err != nil {
+			c.log.Warnf("Skipping bundle %s of catalog %s: failed to parse its properties: %v", b.Name, catalogRef, err)
+			continue
+		}
+		apis := props.GVKs
+		if relation == RelationRequires {
+			apis = make([]property.GVK, 0, len(props.GVKsRequired))
+			for _, gvk := range props.GVKsRequired {
+				apis = append(apis, property.GVK(gvk))
+			}
+		}
+		for _, gvk := range apis {
+			if !matchGVK(query, gvk) {
+				continue
+			}
+			matches = append(matches, APIMatch{
+				Catalog:       catalogRef,
+				Package:       b.Package,
+				Bundle:        b.Name,
+				BundleVersion: bundleVersion(b),
+				Channels:      channels[b.Package+"/"+b.Name],
+				Relation:      relation,
+				API:           gvk,
+			})
+		}
+	}
+
+	sort.SliceStable(matches, func(i, j int) bool {
+		if matches[i].Package != matches[j].Package {
+			return matches[i].Package < matches[j].Package
+		}
+		vi, iok := parseVersion(matches[i].BundleVersion)
+		vj, jok := parseVersion(matches[j].BundleVersion)
+		if iok && jok && !vi.EQ(vj) {
+			return vi.LT(vj)
+		}
+		return matches[i].Bundle < matches[j].Bundle
+	})
+	return matches
+}
+
+// matchGVK reports whether gvk matches the query. Empty query fields match any value
+// and kinds are compared ignoring case.
+func matchGVK(query, gvk property.GVK) bool {
+	return (query.Group == "" || query.Group == gvk.Group) &&
+		(query.Version == "" || query.Version == gvk.Version) &&
+		strings.EqualFold(query.Kind, gvk.Kind)
+}
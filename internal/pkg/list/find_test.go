@@ -0,0 +1,213 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// findTestConfig returns a catalog where the strimzi bundles provide the kafka APIs and a bridge bundle requires them.
+func findTestConfig() *declcfg.DeclarativeConfig {
+	kafkaTopic := property.GVK{Group: "kafka.strimzi.io", Version: "v1beta2", Kind: "KafkaTopic"}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Name: "strimzi-kafka-operator", DefaultChannel: "stable"},
+			{Name: "kafka-bridge", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "strimzi-kafka-operator", Entries: []declcfg.ChannelEntry{
+				{Name: "strimzi-cluster-operator.v0.40.0"},
+				{Name: "strimzi-cluster-operator.v0.41.0", Replaces: "strimzi-cluster-operator.v0.40.0"},
+			}},
+			{Name: "candidate", Package: "strimzi-kafka-operator", Entries: []declcfg.ChannelEntry{
+				{Name: "strimzi-cluster-operator.v0.41.0"},
+			}},
+			{Name: "stable", Package: "kafka-bridge", Entries: []declcfg.ChannelEntry{{Name: "kafka-bridge.v1.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			{Name: "strimzi-cluster-operator.v0.41.0", Package: "strimzi-kafka-operator", Properties: []property.Property{
+				property.MustBuildPackage("strimzi-kafka-operator", "0.41.0"),
+				property.MustBuildGVK(kafkaTopic.Group, kafkaTopic.Version, kafkaTopic.Kind),
+				property.MustBuildGVK("kafka.strimzi.io", "v1beta2", "Kafka"),
+			}},
+			{Name: "strimzi-cluster-operator.v0.40.0", Package: "strimzi-kafka-operator", Properties: []property.Property{
+				property.MustBuildPackage("strimzi-kafka-operator", "0.40.0"),
+				property.MustBuildGVK(kafkaTopic.Group, "v1beta1", kafkaTopic.Kind),
+				property.MustBuildGVK(kafkaTopic.Group, kafkaTopic.Version, kafkaTopic.Kind),
+			}},
+			{Name: "kafka-bridge.v1.0.0", Package: "kafka-bridge", Properties: []property.Property{
+				property.MustBuildPackage("kafka-bridge", "1.0.0"),
+				property.MustBuildGVKRequired(kafkaTopic.Group, kafkaTopic.Version, kafkaTopic.Kind),
+			}},
+		},
+	}
+}
+
+func TestParseGVK(t *testing.T) {
+	testCases := []struct {
+		input     string
+		expected  property.GVK
+		expectErr bool
+	}{
+		{input: "kafka.strimzi.io/v1beta2/KafkaTopic", expected: property.GVK{Group: "kafka.strimzi.io", Version: "v1beta2", Kind: "KafkaTopic"}},
+		{input: "KafkaTopic.kafka.strimzi.io/v1beta2", expected: property.GVK{Group: "kafka.strimzi.io", Version: "v1beta2", Kind: "KafkaTopic"}},
+		{input: "/v1/ConfigMap", expected: property.GVK{Version: "v1", Kind: "ConfigMap"}},
+		{input: "v1/ConfigMap", expected: property.GVK{Version: "v1", Kind: "ConfigMap"}},
+		{input: "v1beta2/KafkaTopic", expected: property.GVK{Version: "v1beta2", Kind: "KafkaTopic"}},
+		{input: "ConfigMap/v1", expected: property.GVK{Version: "v1", Kind: "ConfigMap"}},
+		{input: "KafkaTopic", expected: property.GVK{Kind: "KafkaTopic"}},
+		{input: "", expectErr: true},
+		{input: "kafka.strimzi.io/v1beta2/", expectErr: true},
+		{input: "a/b/c/d", expectErr: true},
+		{input: "apps/v1", expectErr: true},
+		{input: "kafkatopic.kafka.strimzi.io/v1beta2", expectErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.input, func(t *testing.T) {
+			gvk, err := ParseGVK(tc.input)
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, gvk)
+		})
+	}
+}
+
+func TestFindAPI(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	kafkaTopic := property.GVK{Group: "kafka.strimzi.io", Version: "v1beta2", Kind: "KafkaTopic"}
+
+	testCases := []struct {
+		name          string
+		api           string
+		relation      string
+		catalogs      []string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      []APIMatch
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:     "Success Case - Provided API",
+			api:      "KafkaTopic.kafka.strimzi.io/v1beta2",
+			relation: RelationProvides,
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(findTestConfig(), nil)
+			},
+			expected: []APIMatch{
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.40.0", BundleVersion: "0.40.0", Channels: []string{"stable"}, Relation: RelationProvides, API: kafkaTopic},
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.41.0", BundleVersion: "0.41.0", Channels: []string{"stable", "candidate"}, Relation: RelationProvides, API: kafkaTopic},
+			},
+		},
+		{
+			name:     "Success Case - Kind Only Matches Every Version",
+			api:      "kafkatopic",
+			relation: RelationProvides,
+			catalogs: []string{"test-catalog:latest", "broken-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(findTestConfig(), nil)
+				m.EXPECT().CatalogConfig("broken-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expected: []APIMatch{
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.40.0", BundleVersion: "0.40.0", Channels: []string{"stable"}, Relation: RelationProvides, API: property.GVK{Group: "kafka.strimzi.io", Version: "v1beta1", Kind: "KafkaTopic"}},
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.40.0", BundleVersion: "0.40.0", Channels: []string{"stable"}, Relation: RelationProvides, API: kafkaTopic},
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.41.0", BundleVersion: "0.41.0", Channels: []string{"stable", "candidate"}, Relation: RelationProvides, API: kafkaTopic},
+			},
+		},
+		{
+			name:     "Success Case - Malformed Bundle Is Skipped",
+			api:      "KafkaTopic.kafka.strimzi.io/v1beta2",
+			relation: RelationProvides,
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				cfg := findTestConfig()
+				cfg.Bundles[0].Properties = append(cfg.Bundles[0].Properties, property.Property{Type: property.TypeGVK, Value: []byte(`{`)})
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(cfg, nil)
+			},
+			expected: []APIMatch{
+				{Catalog: "test-catalog:latest", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.40.0", BundleVersion: "0.40.0", Channels: []string{"stable"}, Relation: RelationProvides, API: kafkaTopic},
+			},
+		},
+		{
+			name:     "Success Case - Required API",
+			api:      "kafka.strimzi.io/v1beta2/KafkaTopic",
+			relation: RelationRequires,
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(findTestConfig(), nil)
+			},
+			expected: []APIMatch{
+				{Catalog: "test-catalog:latest", Package: "kafka-bridge", Bundle: "kafka-bridge.v1.0.0", BundleVersion: "1.0.0", Channels: []string{"stable"}, Relation: RelationRequires, API: kafkaTopic},
+			},
+		},
+		{
+			name:     "Success Case - No Match",
+			api:      "Kafka.kafka.strimzi.io/v1beta2",
+			relation: RelationRequires,
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(findTestConfig(), nil)
+			},
+		},
+		{
+			name:          "Failure Case - Invalid API",
+			api:           "a/b/c/d",
+			relation:      RelationProvides,
+			catalogs:      []string{"test-catalog:latest"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: `invalid API "a/b/c/d", expected group/version/Kind, Kind.group/version or version/Kind`,
+		},
+		{
+			name:          "Failure Case - Unknown Relation",
+			api:           "KafkaTopic",
+			relation:      "owns",
+			catalogs:      []string{"test-catalog:latest"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: `unknown relation "owns", expected provides or requires`,
+		},
+		{
+			name:          "Failure Case - No Catalogs",
+			api:           "KafkaTopic",
+			relation:      RelationProvides,
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "at least one catalog reference is required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.FindAPI(tc.catalogs, tc.api, tc.relation)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/blang/semver/v4"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// Edge types of the upgrade graph, named after the channel entry field defining them.
+const (
+	EdgeReplaces  = "replaces"
+	EdgeSkips     = "skips"
+	EdgeSkipRange = "skipRange"
+)
+
+// UpgradeGraph is the update graph of a package across one or more of its channels.
+type UpgradeGraph struct {
+	Package        string         `json:"package"`
+	DefaultChannel string         `json:"defaultChannel"`
+	Channels       []GraphChannel `json:"channels"`
+}
+
+// GraphChannel is the update graph of a single channel.
+type GraphChannel struct {
+	Name    string      `json:"name"`
+	Default bool        `json:"default"`
+	Heads   []string    `json:"heads"`
+	Nodes   []string    `json:"nodes"`
+	Edges   []GraphEdge `json:"edges"`
+}
+
+// GraphEdge is an upgrade edge from an installed bundle to the bundle that can replace it.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+// UpgradeGraph builds the update graph of a package from the replaces, skips and
+// skipRange fields of its channel entries. When channelName is empty, every channel
+// of the package is included.
+func (c *CatalogLister) UpgradeGraph(catalogRef, pkgName, channelName string) (*UpgradeGraph, error) {
+	if catalogRef == "" || pkgName == "" {
+		return nil, fmt.Errorf("catalog reference and package name are required")
+	}
+	c.log.Debugf("Building upgrade graph for package %s in catalog %s...", pkgName, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var graph *UpgradeGraph
+	for _, pkg := range cfg.Packages {
+		if pkg.Name == pkgName {
+			graph = &UpgradeGraph{Package: pkg.Name, DefaultChannel: pkg.DefaultChannel}
+			break
+		}
+	}
+	if graph == nil {
+		return nil, fmt.Errorf("package %q not found in catalog %q", pkgName, catalogRef)
+	}
+
+	bundles := bundlesByName(cfg, pkgName)
+	for _, ch := range cfg.Channels {
+		if ch.Package != pkgName || (channelName != "" && ch.Name != channelName) {
+			continue
+		}
+		graphChannel, err := channelGraph(ch, bundles)
+		if err != nil {
+			c.log.Warnf("%v", err)
+		}
+		graphChannel.Default = ch.Name == graph.DefaultChannel
+		graph.Channels = append(graph.Channels, graphChannel)
+	}
+
+	if channelName != "" && len(graph.Channels) == 0 {
+		return nil, fmt.Errorf("channel %q for package %q not found", channelName, pkgName)
+	}
+
+	c.log.Debugf("Built upgrade graph with %d channels.", len(graph.Channels))
+	return graph, nil
+}
+
+// channelGraph builds the update graph of a channel. Edges point from the bundle
+// being upgraded to the bundle that replaces, skips or covers it with its skipRange.
+// Problems found while computing the channel heads are returned as warnings.
+func channelGraph(ch declcfg.Channel, bundles map[string]*declcfg.Bundle) (GraphChannel, error) {
+	heads, headErr := ChannelHeads(ch)
+	graph := GraphChannel{Name: ch.Name, Heads: heads}
+
+	versions := make(map[string]semver.Version)
+	for _, entry := range ch.Entries {
+		graph.Nodes = append(graph.Nodes, entry.Name)
+		if v, ok := parseVersion(bundleVersion(bundles[entry.Name])); ok {
+			versions[entry.Name] = v
+		}
+	}
+
+	for _, entry := range ch.Entries {
+		if entry.Replaces != "" {
+			graph.Edges = append(graph.Edges, GraphEdge{From: entry.Replaces, To: entry.Name, Type: EdgeReplaces})
+		}
+		for _, skip := range entry.Skips {
+			graph.Edges = append(graph.Edges, GraphEdge{From: skip, To: entry.Name, Type: EdgeSkips})
+		}
+		if entry.SkipRange == "" {
+			continue
+		}
+		skipRange, err := semver.ParseRange(entry.SkipRange)
+		if err != nil {
+			continue
+		}
+		for _, other := range ch.Entries {
+			if v, ok := versions[other.Name]; ok && other.Name != entry.Name && skipRange(v) {
+				graph.Edges = append(graph.Edges, GraphEdge{From: other.Name, To: entry.Name, Type: EdgeSkipRange})
+			}
+		}
+	}
+
+	return graph, headErr
+}
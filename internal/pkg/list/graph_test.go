@@ -0,0 +1,165 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// graphTestConfig returns a catalog with a package whose stable channel uses
+// every kind of upgrade edge.
+func graphTestConfig() *declcfg.DeclarativeConfig {
+	bundle := func(name, version string) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:       name,
+			Package:    "pkg1",
+			Image:      "quay.io/example/" + name,
+			Properties: []property.Property{property.MustBuildPackage("pkg1", version)},
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "pkg1", DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{
+			{
+				Name:    "stable",
+				Package: "pkg1",
+				Entries: []declcfg.ChannelEntry{
+					{Name: "pkg1.v1.0.0"},
+					{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0"},
+					{Name: "pkg1.v1.1.1", Replaces: "pkg1.v1.1.0", Skips: []string{"pkg1.v1.0.0"}},
+					{Name: "pkg1.v1.2.0", Replaces: "pkg1.v1.1.1", SkipRange: ">=1.0.0 <1.2.0"},
+				},
+			},
+			{
+				Name:    "fast",
+				Package: "pkg1",
+				Entries: []declcfg.ChannelEntry{
+					{Name: "pkg1.v1.2.0"},
+				},
+			},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("pkg1.v1.0.0", "1.0.0"),
+			bundle("pkg1.v1.1.0", "1.1.0"),
+			bundle("pkg1.v1.1.1", "1.1.1"),
+			bundle("pkg1.v1.2.0", "1.2.0"),
+		},
+	}
+}
+
+func TestUpgradeGraph(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	stable := GraphChannel{
+		Name:    "stable",
+		Default: true,
+		Heads:   []string{"pkg1.v1.2.0"},
+		Nodes:   []string{"pkg1.v1.0.0", "pkg1.v1.1.0", "pkg1.v1.1.1", "pkg1.v1.2.0"},
+		Edges: []GraphEdge{
+			{From: "pkg1.v1.0.0", To: "pkg1.v1.1.0", Type: EdgeReplaces},
+			{From: "pkg1.v1.1.0", To: "pkg1.v1.1.1", Type: EdgeReplaces},
+			{From: "pkg1.v1.0.0", To: "pkg1.v1.1.1", Type: EdgeSkips},
+			{From: "pkg1.v1.1.1", To: "pkg1.v1.2.0", Type: EdgeReplaces},
+			{From: "pkg1.v1.0.0", To: "pkg1.v1.2.0", Type: EdgeSkipRange},
+			{From: "pkg1.v1.1.0", To: "pkg1.v1.2.0", Type: EdgeSkipRange},
+			{From: "pkg1.v1.1.1", To: "pkg1.v1.2.0", Type: EdgeSkipRange},
+		},
+	}
+	fast := GraphChannel{
+		Name:  "fast",
+		Heads: []string{"pkg1.v1.2.0"},
+		Nodes: []string{"pkg1.v1.2.0"},
+	}
+
+	testCases := []struct {
+		name          string
+		packageName   string
+		channelName   string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      *UpgradeGraph
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:        "Success Case - All Channels",
+			packageName: "pkg1",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(graphTestConfig(), nil)
+			},
+			expected: &UpgradeGraph{Package: "pkg1", DefaultChannel: "stable", Channels: []GraphChannel{stable, fast}},
+		},
+		{
+			name:        "Success Case - Single Channel",
+			packageName: "pkg1",
+			channelName: "fast",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(graphTestConfig(), nil)
+			},
+			expected: &UpgradeGraph{Package: "pkg1", DefaultChannel: "stable", Channels: []GraphChannel{fast}},
+		},
+		{
+			name:        "Failure Case - Channel Not Found",
+			packageName: "pkg1",
+			channelName: "nonexistent",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(graphTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "nonexistent" for package "pkg1" not found`,
+		},
+		{
+			name:        "Failure Case - Package Not Found",
+			packageName: "nonexistent",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(graphTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "nonexistent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name:        "Failure Case - CatalogConfig returns error",
+			packageName: "pkg1",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+		{
+			name:          "Failure Case - Missing PackageName",
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "catalog reference and package name are required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.UpgradeGraph("test-catalog:latest", tc.packageName, tc.channelName)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
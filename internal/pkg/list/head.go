@@ -0,0 +1,113 @@
+package list
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// ChannelHeads computes the heads of a channel from its upgrade graph. The entries of
+// a File-Based Catalog channel are unordered, so the head is not the last entry but
+// the entry that no other entry of the channel replaces or skips. skipRange does not
+// take part in the computation, as OLM only uses it to offer extra upgrade edges.
+//
+// A well formed channel has exactly one head. The heads are returned in entry order
+// together with a non-nil error when the channel has several heads, none at all, or
+// when its replaces and skips edges form a cycle. Callers are expected to report
+// that error as a warning and keep using the returned heads.
+func ChannelHeads(ch declcfg.Channel) ([]string, error) {
+	if len(ch.Entries) == 0 {
+		return nil, nil
+	}
+
+	inChannel := make(map[string]bool, len(ch.Entries))
+	for _, entry := range ch.Entries {
+		inChannel[entry.Name] = true
+	}
+
+	replaced := make(map[string]bool)
+	// upgradesFrom maps every entry to the entries it replaces or skips.
+	upgradesFrom := make(map[string][]string)
+	for _, entry := range ch.Entries {
+		for _, old := range append([]string{entry.Replaces}, entry.Skips...) {
+			if old == "" {
+				continue
+			}
+			replaced[old] = true
+			if inChannel[old] {
+				upgradesFrom[entry.Name] = append(upgradesFrom[entry.Name], old)
+			}
+		}
+	}
+
+	var heads []string
+	for _, entry := range ch.Entries {
+		if !replaced[entry.Name] {
+			heads = append(heads, entry.Name)
+		}
+	}
+
+	var errs []error
+	switch len(heads) {
+	case 0:
+		errs = append(errs, fmt.Errorf("channel %q of package %q has no head, every entry is replaced or skipped by another one", ch.Name, ch.Package))
+	case 1:
+	default:
+		errs = append(errs, fmt.Errorf("channel %q of package %q has %d heads: %s", ch.Name, ch.Package, len(heads), strings.Join(heads, ", ")))
+	}
+	if cycle := findUpgradeCycle(ch.Entries, upgradesFrom); cycle != nil {
+		errs = append(errs, fmt.Errorf("channel %q of package %q has an upgrade cycle: %s", ch.Name, ch.Package, strings.Join(cycle, " -> ")))
+	}
+
+	return heads, errors.Join(errs...)
+}
+
+// findUpgradeCycle returns the first cycle found in the replaces and skips edges of a
+// channel, listing the bundles from the oldest one back to itself, or nil.
+func findUpgradeCycle(entries []declcfg.ChannelEntry, upgradesFrom map[string][]string) []string {
+	const (
+		unvisited = iota
+		inProgress
+		done
+	)
+	state := make(map[string]int)
+	var stack []string
+
+	var visit func(name string) []string
+	visit = func(name string) []string {
+		state[name] = inProgress
+		stack = append(stack, name)
+		for _, old := range upgradesFrom[name] {
+			switch state[old] {
+			case inProgress:
+				// Walk the stack back to where the cycle starts and list it in upgrade order.
+				var cycle []string
+				for i := len(stack) - 1; i >= 0; i-- {
+					cycle = append(cycle, stack[i])
+					if stack[i] == old {
+						break
+					}
+				}
+				return append(cycle, cycle[0])
+			case unvisited:
+				if cycle := visit(old); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[name] = done
+		return nil
+	}
+
+	for _, entry := range entries {
+		if state[entry.Name] == unvisited {
+			if cycle := visit(entry.Name); cycle != nil {
+				return cycle
+			}
+		}
+	}
+	return nil
+}
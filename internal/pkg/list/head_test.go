@@ -0,0 +1,84 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChannelHeads(t *testing.T) {
+	testCases := []struct {
+		name          string
+		entries       []declcfg.ChannelEntry
+		expected      []string
+		expectedError string
+	}{
+		{
+			name: "Success Case - Head Is Not The Last Entry",
+			entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.2.0", Replaces: "pkg1.v1.1.0"},
+				{Name: "pkg1.v1.0.0"},
+				{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0"},
+			},
+			expected: []string{"pkg1.v1.2.0"},
+		},
+		{
+			name: "Success Case - Skipped Entries Are Not Heads",
+			entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.0.0"},
+				{Name: "pkg1.v1.0.1"},
+				{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0", Skips: []string{"pkg1.v1.0.1"}},
+			},
+			expected: []string{"pkg1.v1.1.0"},
+		},
+		{
+			name: "Success Case - SkipRange Does Not Remove Heads",
+			entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.0.0"},
+				{Name: "pkg1.v1.1.0", SkipRange: "<1.1.0"},
+			},
+			expected:      []string{"pkg1.v1.0.0", "pkg1.v1.1.0"},
+			expectedError: `channel "stable" of package "pkg1" has 2 heads: pkg1.v1.0.0, pkg1.v1.1.0`,
+		},
+		{
+			name: "Warning Case - Cycle",
+			entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.0.0"},
+				{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.2.0"},
+				{Name: "pkg1.v1.2.0", Replaces: "pkg1.v1.1.0"},
+				{Name: "pkg1.v1.3.0", Replaces: "pkg1.v1.0.0"},
+			},
+			expected:      []string{"pkg1.v1.3.0"},
+			expectedError: `channel "stable" of package "pkg1" has an upgrade cycle: pkg1.v1.2.0 -> pkg1.v1.1.0 -> pkg1.v1.2.0`,
+		},
+		{
+			name: "Warning Case - No Head",
+			entries: []declcfg.ChannelEntry{
+				{Name: "pkg1.v1.0.0", Replaces: "pkg1.v1.1.0"},
+				{Name: "pkg1.v1.1.0", Replaces: "pkg1.v1.0.0"},
+			},
+			expected: nil,
+			expectedError: `channel "stable" of package "pkg1" has no head, every entry is replaced or skipped by another one` + "\n" +
+				`channel "stable" of package "pkg1" has an upgrade cycle: pkg1.v1.1.0 -> pkg1.v1.0.0 -> pkg1.v1.1.0`,
+		},
+		{
+			name:     "Success Case - Empty Channel",
+			entries:  nil,
+			expected: nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			heads, err := ChannelHeads(declcfg.Channel{Name: "stable", Package: "pkg1", Entries: tc.entries})
+
+			assert.Equal(t, tc.expected, heads)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,153 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+const (
+	// ImageSetConfigKind is the kind of oc-mirror image set configurations.
+	ImageSetConfigKind = "ImageSetConfiguration"
+	// ImageSetConfigAPIVersion is the oc-mirror v2 image set configuration API version.
+	ImageSetConfigAPIVersion = "mirror.openshift.io/v2alpha1"
+)
+
+// ImageSetConfiguration is an oc-mirror v2 image set configuration mirroring operators.
+type ImageSetConfiguration struct {
+	Kind       string         `json:"kind"`
+	APIVersion string         `json:"apiVersion"`
+	Mirror     ImageSetMirror `json:"mirror"`
+}
+
+// ImageSetMirror lists the content an image set configuration mirrors.
+type ImageSetMirror struct {
+	Operators []ImageSetOperator `json:"operators"`
+}
+
+// ImageSetOperator selects the packages to mirror from a catalog.
+type ImageSetOperator struct {
+	Catalog  string            `json:"catalog"`
+	Packages []ImageSetPackage `json:"packages"`
+}
+
+// ImageSetPackage selects a package to mirror. Without channels, oc-mirror mirrors the head of
+// the default channel. DefaultChannel is set when the channels leave the default one out.
+type ImageSetPackage struct {
+	Name           string            `json:"name"`
+	DefaultChannel string            `json:"defaultChannel,omitempty"`
+	Channels       []ImageSetChannel `json:"channels,omitempty"`
+}
+
+// ImageSetChannel selects a channel to mirror, optionally narrowed to a range of versions.
+type ImageSetChannel struct {
+	Name       string `json:"name"`
+	MinVersion string `json:"minVersion,omitempty"`
+	MaxVersion string `json:"maxVersion,omitempty"`
+}
+
+// ImageSetConfig returns the oc-mirror image set configuration mirroring the packages selected by
+// filters from a catalog. Packages, channels and version bounds are checked against the catalog,
+// filters on the same package add up, and a channel may be selected only once. When the selected
+// channels leave the default channel of a package out, the newest selected channel becomes its
+// default channel so that the mirrored catalog stays valid.
+func (c *CatalogLister) ImageSetConfig(catalogRef string, filters []PackageFilter) (*ImageSetConfiguration, error) {
+	if catalogRef == "" {
+		return nil, fmt.Errorf("catalog reference is required")
+	}
+	if len(filters) == 0 {
+		return nil, fmt.Errorf("at least one package filter is required")
+	}
+	c.log.Debugf("Generating image set configuration for catalog %s...", catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	operator := ImageSetOperator{Catalog: catalogRef}
+	index := make(map[string]int)
+	for _, filter := range filters {
+		if err := validatePackageFilter(cfg, filter); err != nil {
+			return nil, err
+		}
+		i, ok := index[filter.Package]
+		if !ok {
+			i = len(operator.Packages)
+			index[filter.Package] = i
+			operator.Packages = append(operator.Packages, ImageSetPackage{Name: filter.Package})
+		}
+		if filter.Channel == "" {
+			continue
+		}
+		pkg := &operator.Packages[i]
+		if slices.ContainsFunc(pkg.Channels, func(ch ImageSetChannel) bool { return ch.Name == filter.Channel }) {
+			return nil, fmt.Errorf("channel %q of package %q is selected more than once", filter.Channel, filter.Package)
+		}
+		pkg.Channels = append(pkg.Channels, ImageSetChannel{Name: filter.Channel, MinVersion: filter.MinVersion, MaxVersion: filter.MaxVersion})
+	}
+
+	for i := range operator.Packages {
+		pkg := &operator.Packages[i]
+		if len(pkg.Channels) == 0 {
+			continue
+		}
+		selected := &declcfg.DeclarativeConfig{}
+		defaultChannel := ""
+		for _, p := range cfg.Packages {
+			if p.Name == pkg.Name {
+				defaultChannel = p.DefaultChannel
+			}
+		}
+		for _, ch := range cfg.Channels {
+			if ch.Package == pkg.Name && slices.ContainsFunc(pkg.Channels, func(s ImageSetChannel) bool { return s.Name == ch.Name }) {
+				selected.Channels = append(selected.Channels, ch)
+			}
+		}
+		if !slices.ContainsFunc(selected.Channels, func(ch declcfg.Channel) bool { return ch.Name == defaultChannel }) {
+			pkg.DefaultChannel = newestChannel(selected, pkg.Name, bundlesByName(cfg, pkg.Name))
+			c.log.Debugf("Default channel %s of package %s is not selected, using %s instead", defaultChannel, pkg.Name, pkg.DefaultChannel)
+		}
+	}
+
+	c.log.Debugf("Generated image set configuration with %d packages", len(operator.Packages))
+	return &ImageSetConfiguration{
+		Kind:       ImageSetConfigKind,
+		APIVersion: ImageSetConfigAPIVersion,
+		Mirror:     ImageSetMirror{Operators: []ImageSetOperator{operator}},
+	}, nil
+}
+
+// validatePackageFilter checks that the package and channel of a filter exist in cfg and that
+// its version bounds are versions of bundles in that channel.
+func validatePackageFilter(cfg *declcfg.DeclarativeConfig, filter PackageFilter) error {
+	if !packageExists(cfg, filter.Package) {
+		return fmt.Errorf("package %q not found in catalog", filter.Package)
+	}
+	if filter.Channel == "" {
+		return nil
+	}
+	idx := slices.IndexFunc(cfg.Channels, func(ch declcfg.Channel) bool {
+		return ch.Package == filter.Package && ch.Name == filter.Channel
+	})
+	if idx < 0 {
+		return fmt.Errorf("channel %q for package %q not found", filter.Channel, filter.Package)
+	}
+	if _, err := versionFilter(filter.MinVersion, filter.MaxVersion); err != nil {
+		return fmt.Errorf("invalid package filter %q: %w", filter, err)
+	}
+
+	bundles := bundlesByName(cfg, filter.Package)
+	for _, version := range []string{filter.MinVersion, filter.MaxVersion} {
+		if version == "" {
+			continue
+		}
+		found := slices.ContainsFunc(cfg.Channels[idx].Entries, func(entry declcfg.ChannelEntry) bool {
+			return compareVersions(bundleVersion(bundles[entry.Name]), version) == 0
+		})
+		if !found {
+			return fmt.Errorf("version %q not found in channel %q of package %q", version, filter.Channel, filter.Package)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImageSetConfig(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	catalogRef := "registry.example.com/catalog:latest"
+	bundle := func(pkg, version string) declcfg.Bundle {
+		return declcfg.Bundle{
+			Schema:     declcfg.SchemaBundle,
+			Name:       pkg + ".v" + version,
+			Package:    pkg,
+			Image:      "registry.example.com/" + pkg + "-bundle:v" + version,
+			Properties: []property.Property{property.MustBuildPackage(pkg, version)},
+		}
+	}
+	cfg := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Schema: declcfg.SchemaPackage, Name: "logging", DefaultChannel: "stable-6.0"},
+			{Schema: declcfg.SchemaPackage, Name: "jaeger", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Schema: declcfg.SchemaChannel, Name: "stable-5.7", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v5.7.0"},
+			}},
+			{Schema: declcfg.SchemaChannel, Name: "stable-5.8", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v5.8.0"},
+				{Name: "logging.v5.8.1", Replaces: "logging.v5.8.0"},
+				{Name: "logging.v5.8.2", Replaces: "logging.v5.8.1"},
+			}},
+			{Schema: declcfg.SchemaChannel, Name: "stable-6.0", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v6.0.0"},
+			}},
+			{Schema: declcfg.SchemaChannel, Name: "stable", Package: "jaeger", Entries: []declcfg.ChannelEntry{
+				{Name: "jaeger.v1.0.0"},
+			}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("logging", "5.7.0"), bundle("logging", "5.8.0"), bundle("logging", "5.8.1"),
+			bundle("logging", "5.8.2"), bundle("logging", "6.0.0"), bundle("jaeger", "1.0.0"),
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		filters       []PackageFilter
+		setupMocks    func(c *mock.MockCataloger)
+		expected      []ImageSetPackage
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name: "Success Case - Default Channel Not Selected",
+			filters: []PackageFilter{
+				{Package: "logging", Channel: "stable-5.7"},
+				{Package: "jaeger"},
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.1"},
+			},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []ImageSetPackage{
+				{Name: "logging", DefaultChannel: "stable-5.8", Channels: []ImageSetChannel{
+					{Name: "stable-5.7"},
+					{Name: "stable-5.8", MinVersion: "5.8.1"},
+				}},
+				{Name: "jaeger"},
+			},
+		},
+		{
+			name: "Success Case - Default Channel Selected",
+			filters: []PackageFilter{
+				{Package: "logging", Channel: "stable-6.0"},
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.0", MaxVersion: "5.8.2"},
+			},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []ImageSetPackage{
+				{Name: "logging", Channels: []ImageSetChannel{
+					{Name: "stable-6.0"},
+					{Name: "stable-5.8", MinVersion: "5.8.0", MaxVersion: "5.8.2"},
+				}},
+			},
+		},
+		{
+			name:    "Failure Case - Version Not In Channel",
+			filters: []PackageFilter{{Package: "logging", Channel: "stable-5.8", MinVersion: "5.7.0"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `version "5.7.0" not found in channel "stable-5.8" of package "logging"`,
+		},
+		{
+			name:    "Failure Case - Inverted Version Range",
+			filters: []PackageFilter{{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.2", MaxVersion: "5.8.0"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `invalid package filter "logging:stable-5.8@5.8.2-5.8.0": minimum version 5.8.2 is greater than maximum version 5.8.0`,
+		},
+		{
+			name: "Failure Case - Channel Selected Twice",
+			filters: []PackageFilter{
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.0", MaxVersion: "5.8.0"},
+				{Package: "logging", Channel: "stable-5.8", MinVersion: "5.8.2"},
+			},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "stable-5.8" of package "logging" is selected more than once`,
+		},
+		{
+			name:    "Failure Case - Channel Not Found",
+			filters: []PackageFilter{{Package: "logging", Channel: "fast"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "fast" for package "logging" not found`,
+		},
+		{
+			name:    "Failure Case - Package Not Found",
+			filters: []PackageFilter{{Package: "missing"}},
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `package "missing" not found in catalog`,
+		},
+		{
+			name:          "Failure Case - No Filters",
+			setupMocks:    func(c *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "at least one package filter is required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mock.NewMockImager(mockCtrl))
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.ImageSetConfig(catalogRef, tc.filters)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, &ImageSetConfiguration{
+				Kind:       ImageSetConfigKind,
+				APIVersion: ImageSetConfigAPIVersion,
+				Mirror: ImageSetMirror{Operators: []ImageSetOperator{
+					{Catalog: catalogRef, Packages: tc.expected},
+				}},
+			}, result)
+		})
+	}
+}
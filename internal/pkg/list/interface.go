@@ -12,11 +12,13 @@ type Logger interface {
 	Infof(format string, args ...interface{})
 	Info(args ...interface{})
 	Debugf(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
 }
 
 // Imager defines the interface this package expects for image operations.
 type Imager interface {
 	RemoteInfo(imageRef string) (string, string, digest.Digest, error)
+	RepositoryTags(repository string) ([]string, error)
 }
 
 // Cataloger defines the interface this package expects for catalog operations.
@@ -0,0 +1,192 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// PackageMatrix shows the channels of a package and their heads across OpenShift versions.
+type PackageMatrix struct {
+	Package  string          `json:"package"`
+	Versions []MatrixVersion `json:"versions"`
+	// Channels holds every channel found in any version, sorted by name.
+	Channels []MatrixChannel `json:"channels"`
+}
+
+// MatrixVersion is the catalog a package was read from for an OpenShift version. Catalog is empty
+// when no catalog was found for the version and DefaultChannel when the catalog lacks the package.
+type MatrixVersion struct {
+	Version        string `json:"version"`
+	Catalog        string `json:"catalog,omitempty"`
+	DefaultChannel string `json:"defaultChannel,omitempty"`
+}
+
+// MatrixChannel is a channel of a package and its head in each version shipping it.
+type MatrixChannel struct {
+	Name string `json:"name"`
+	// Heads maps OpenShift versions to the head of the channel.
+	Heads map[string]string `json:"heads"`
+}
+
+// ParseOCPVersions expands a comma-separated list of OpenShift versions and inclusive ranges,
+// e.g. 4.12-4.18 or 4.12,4.14,4.16, into a sorted list of unique minor versions.
+func ParseOCPVersions(spec string) ([]string, error) {
+	minors := map[int]bool{}
+	for _, item := range strings.Split(spec, ",") {
+		item = strings.TrimSpace(item)
+		from, to, isRange := strings.Cut(item, "-")
+		first, err := parseOCPMinor(from)
+		if err != nil {
+			return nil, err
+		}
+		last := first
+		if isRange {
+			if last, err = parseOCPMinor(to); err != nil {
+				return nil, err
+			}
+			if last < first {
+				return nil, fmt.Errorf("invalid OpenShift version range %q, %s is older than %s", item, to, from)
+			}
+		}
+		for minor := first; minor <= last; minor++ {
+			minors[minor] = true
+		}
+	}
+
+	sorted := make([]int, 0, len(minors))
+	for minor := range minors {
+		sorted = append(sorted, minor)
+	}
+	sort.Ints(sorted)
+	versions := make([]string, len(sorted))
+	for i, minor := range sorted {
+		versions[i] = fmt.Sprintf("4.%d", minor)
+	}
+	return versions, nil
+}
+
+// parseOCPMinor returns the minor of an OpenShift 4 version such as 4.16.
+func parseOCPMinor(version string) (int, error) {
+	minor, ok := strings.CutPrefix(strings.TrimSpace(version), "4.")
+	if !ok {
+		return 0, fmt.Errorf("invalid OpenShift version %q, expected 4.<minor>", version)
+	}
+	n, err := strconv.Atoi(minor)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid OpenShift version %q, expected 4.<minor>", version)
+	}
+	return n, nil
+}
+
+// Matrix reads a package from the catalogs of each OpenShift version and lines up the heads of its
+// channels. The first catalog of the given sources shipping the package is used for each version,
+// so catalogs are loaded one at a time and only until the package is found. A catalog is kept in
+// memory only while versions sharing its reference remain. Versions without catalogs are reported
+// with an empty catalog instead of failing the matrix.
+func (c *CatalogLister) Matrix(pkgName string, versions []string, sources []CatalogSource) (*PackageMatrix, error) {
+	if pkgName == "" || len(versions) == 0 {
+		return nil, fmt.Errorf("a package name and at least one OpenShift version are required")
+	}
+	c.log.Debugf("Building the matrix of package %s for %d OpenShift versions...", pkgName, len(versions))
+
+	catalogs := make([][]Catalog, len(versions))
+	// uses counts the versions left to process for each catalog reference.
+	uses := map[string]int{}
+	for i, version := range versions {
+		var err error
+		if catalogs[i], err = c.Catalogs(version, sources); err != nil {
+			c.log.Warnf("Skipping OpenShift %s: %v", version, err)
+			continue
+		}
+		for _, catalog := range catalogs[i] {
+			uses[catalog.Reference]++
+		}
+	}
+
+	matrix := &PackageMatrix{Package: pkgName}
+	channels := map[string]map[string]string{}
+	// loaded keeps the catalogs shared with versions left to process, or nil for those that failed
+	// to load, so that they are read once. The other catalogs are released with their version row.
+	loaded := map[string]*declcfg.DeclarativeConfig{}
+	for i, version := range versions {
+		row, heads := c.matrixVersion(pkgName, version, catalogs[i], loaded, uses)
+		for _, catalog := range catalogs[i] {
+			if uses[catalog.Reference]--; uses[catalog.Reference] == 0 {
+				delete(loaded, catalog.Reference)
+			}
+		}
+		matrix.Versions = append(matrix.Versions, row)
+		for channel, head := range heads {
+			if channels[channel] == nil {
+				channels[channel] = map[string]string{}
+			}
+			channels[channel][version] = head
+		}
+	}
+
+	for _, name := range sortedKeys(channels) {
+		matrix.Channels = append(matrix.Channels, MatrixChannel{Name: name, Heads: channels[name]})
+	}
+	if len(matrix.Channels) == 0 {
+		return nil, fmt.Errorf("package %q not found in the catalogs of OpenShift %s", pkgName, strings.Join(versions, ", "))
+	}
+
+	c.log.Debugf("Found %d channels.", len(matrix.Channels))
+	return matrix, nil
+}
+
+// matrixVersion finds a package in the catalogs of a version and returns the heads of its channels.
+// Catalogs are read from loaded, or loaded and added to it when other versions still use them.
+func (c *CatalogLister) matrixVersion(pkgName, version string, catalogs []Catalog, loaded map[string]*declcfg.DeclarativeConfig, uses map[string]int) (MatrixVersion, map[string]string) {
+	row := MatrixVersion{Version: version}
+	if len(catalogs) == 0 {
+		return row, nil
+	}
+
+	for _, catalog := range catalogs {
+		cfg, ok := loaded[catalog.Reference]
+		if !ok {
+			var err error
+			if cfg, err = c.cataloger.CatalogConfig(catalog.Reference); err != nil {
+				c.log.Warnf("Skipping catalog %s: %v", catalog.Reference, err)
+				cfg = nil
+			}
+			if uses[catalog.Reference] > 1 {
+				loaded[catalog.Reference] = cfg
+			}
+		}
+		if cfg == nil {
+			continue
+		}
+		if row.Catalog == "" {
+			row.Catalog = catalog.Reference
+		}
+		for _, pkg := range cfg.Packages {
+			if pkg.Name != pkgName {
+				continue
+			}
+			row.Catalog = catalog.Reference
+			row.DefaultChannel = pkg.DefaultChannel
+			heads := map[string]string{}
+			for _, ch := range cfg.Channels {
+				if ch.Package != pkgName {
+					continue
+				}
+				names, err := ChannelHeads(ch)
+				if err != nil {
+					c.log.Warnf("%v", err)
+				}
+				heads[ch.Name] = strings.Join(names, ",")
+			}
+			return row, heads
+		}
+	}
+	if row.Catalog == "" {
+		c.log.Warnf("Skipping OpenShift %s: failed to load any catalog", version)
+	}
+	return row, nil
+}
@@ -0,0 +1,192 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestParseOCPVersions(t *testing.T) {
+	testCases := []struct {
+		spec          string
+		expected      []string
+		expectedError string
+	}{
+		{spec: "4.12-4.15", expected: []string{"4.12", "4.13", "4.14", "4.15"}},
+		{spec: "4.18, 4.12,4.14-4.16,4.14", expected: []string{"4.12", "4.14", "4.15", "4.16", "4.18"}},
+		{spec: "4.16", expected: []string{"4.16"}},
+		{spec: "4.18-4.12", expectedError: `invalid OpenShift version range "4.18-4.12", 4.12 is older than 4.18`},
+		{spec: "3.11", expectedError: `invalid OpenShift version "3.11", expected 4.<minor>`},
+		{spec: "4.x", expectedError: `invalid OpenShift version "4.x", expected 4.<minor>`},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.spec, func(t *testing.T) {
+			versions, err := ParseOCPVersions(tc.spec)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, versions)
+		})
+	}
+}
+
+func TestMatrix(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	sources := []CatalogSource{
+		{Name: "redhat", Repository: "registry.example.com/redhat-index"},
+		{Name: "community", Repository: "registry.example.com/community-index"},
+	}
+	catalog := func(defaultChannel string, channels ...declcfg.Channel) *declcfg.DeclarativeConfig {
+		return &declcfg.DeclarativeConfig{
+			Packages: []declcfg.Package{{Name: "logging", DefaultChannel: defaultChannel}},
+			Channels: channels,
+		}
+	}
+	channel := func(name string, entries ...string) declcfg.Channel {
+		ch := declcfg.Channel{Name: name, Package: "logging"}
+		for i, entry := range entries {
+			e := declcfg.ChannelEntry{Name: entry}
+			if i > 0 {
+				e.Replaces = entries[i-1]
+			}
+			ch.Entries = append(ch.Entries, e)
+		}
+		return ch
+	}
+
+	testCases := []struct {
+		name          string
+		versions      []string
+		setupMocks    func(i *mock.MockImager, c *mock.MockCataloger)
+		expected      *PackageMatrix
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:     "Success Case - Channels Across Versions",
+			versions: []string{"4.12", "4.14", "4.16"},
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.12").Return("name", "tag", digest.FromString("a"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.12").Return("", "", digest.Digest(""), errors.New("not found"))
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.14").Return("name", "tag", digest.FromString("b"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.14").Return("name", "tag", digest.FromString("c"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.16").Return("", "", digest.Digest(""), errors.New("not found"))
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.16").Return("", "", digest.Digest(""), errors.New("not found"))
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.12").Return(catalog("stable-5.8", channel("stable-5.8", "logging.v5.8.0", "logging.v5.8.1")), nil)
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.14").Return(&declcfg.DeclarativeConfig{}, nil)
+				c.EXPECT().CatalogConfig("registry.example.com/community-index:v4.14").Return(catalog("stable-6.0",
+					channel("stable-5.8", "logging.v5.8.1", "logging.v5.8.2"),
+					channel("stable-6.0", "logging.v6.0.0"),
+				), nil)
+			},
+			expected: &PackageMatrix{
+				Package: "logging",
+				Versions: []MatrixVersion{
+					{Version: "4.12", Catalog: "registry.example.com/redhat-index:v4.12", DefaultChannel: "stable-5.8"},
+					{Version: "4.14", Catalog: "registry.example.com/community-index:v4.14", DefaultChannel: "stable-6.0"},
+					{Version: "4.16"},
+				},
+				Channels: []MatrixChannel{
+					{Name: "stable-5.8", Heads: map[string]string{"4.12": "logging.v5.8.1", "4.14": "logging.v5.8.2"}},
+					{Name: "stable-6.0", Heads: map[string]string{"4.14": "logging.v6.0.0"}},
+				},
+			},
+		},
+		{
+			name:     "Success Case - Catalogs Loaded Lazily Once",
+			versions: []string{"4.12", "4.14"},
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.12").Return("name", "tag", digest.FromString("a"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.12").Return("name", "tag", digest.FromString("b"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.14").Return("name", "tag", digest.FromString("c"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.14").Return("name", "tag", digest.FromString("d"), nil)
+				// The community catalogs are never loaded as the redhat ones ship the package.
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.12").Return(catalog("stable", channel("stable", "logging.v1.0.0")), nil).Times(1)
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.14").Return(catalog("stable", channel("stable", "logging.v1.1.0")), nil).Times(1)
+			},
+			expected: &PackageMatrix{
+				Package: "logging",
+				Versions: []MatrixVersion{
+					{Version: "4.12", Catalog: "registry.example.com/redhat-index:v4.12", DefaultChannel: "stable"},
+					{Version: "4.14", Catalog: "registry.example.com/redhat-index:v4.14", DefaultChannel: "stable"},
+				},
+				Channels: []MatrixChannel{
+					{Name: "stable", Heads: map[string]string{"4.12": "logging.v1.0.0", "4.14": "logging.v1.1.0"}},
+				},
+			},
+		},
+		{
+			name:     "Failure Case - Package Not Found",
+			versions: []string{"4.12"},
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				i.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.12").Return("name", "tag", digest.FromString("a"), nil)
+				i.EXPECT().RemoteInfo("registry.example.com/community-index:v4.12").Return("", "", digest.Digest(""), errors.New("not found"))
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.12").Return(&declcfg.DeclarativeConfig{}, nil)
+			},
+			expectErr:     true,
+			expectedError: `package "logging" not found in the catalogs of OpenShift 4.12`,
+		},
+		{
+			name:          "Failure Case - No Versions",
+			setupMocks:    func(i *mock.MockImager, c *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "a package name and at least one OpenShift version are required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImager := mock.NewMockImager(mockCtrl)
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mockImager)
+
+			tc.setupMocks(mockImager, mockCataloger)
+
+			result, err := lister.Matrix("logging", tc.versions, sources)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestMatrix_SharedCatalog(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// A catalog published under one tag for every version is read once.
+	sources := []CatalogSource{{Name: "custom", Repository: "registry.example.com/custom-index", TagTemplate: "latest"}}
+	mockImager := mock.NewMockImager(mockCtrl)
+	mockCataloger := mock.NewMockCataloger(mockCtrl)
+	mockImager.EXPECT().RemoteInfo("registry.example.com/custom-index:latest").Return("name", "tag", digest.FromString("a"), nil).Times(2)
+	mockCataloger.EXPECT().CatalogConfig("registry.example.com/custom-index:latest").Return(&declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "logging", DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{{Name: "stable", Package: "logging", Entries: []declcfg.ChannelEntry{{Name: "logging.v1.0.0"}}}},
+	}, nil).Times(1)
+	lister := NewCatalogLister(log.New("error"), mockCataloger, mockImager)
+
+	result, err := lister.Matrix("logging", []string{"4.12", "4.14"}, sources)
+	require.NoError(t, err)
+	assert.Equal(t, []MatrixChannel{
+		{Name: "stable", Heads: map[string]string{"4.12": "logging.v1.0.0", "4.14": "logging.v1.0.0"}},
+	}, result.Channels)
+}
@@ -91,6 +91,23 @@ func (mr *MockLoggerMockRecorder) Infof(format any, args ...any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Infof", reflect.TypeOf((*MockLogger)(nil).Infof), varargs...)
 }
 
+// Warnf mocks base method.
+func (m *MockLogger) Warnf(format string, args ...any) {
+	m.ctrl.T.Helper()
+	varargs := []any{format}
+	for _, a := range args {
+		varargs = append(varargs, a)
+	}
+	m.ctrl.Call(m, "Warnf", varargs...)
+}
+
+// Warnf indicates an expected call of Warnf.
+func (mr *MockLoggerMockRecorder) Warnf(format any, args ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{format}, args...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Warnf", reflect.TypeOf((*MockLogger)(nil).Warnf), varargs...)
+}
+
 // MockImager is a mock of Imager interface.
 type MockImager struct {
 	ctrl     *gomock.Controller
@@ -132,6 +149,21 @@ func (mr *MockImagerMockRecorder) RemoteInfo(imageRef any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoteInfo", reflect.TypeOf((*MockImager)(nil).RemoteInfo), imageRef)
 }
 
+// RepositoryTags mocks base method.
+func (m *MockImager) RepositoryTags(repository string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RepositoryTags", repository)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RepositoryTags indicates an expected call of RepositoryTags.
+func (mr *MockImagerMockRecorder) RepositoryTags(repository any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RepositoryTags", reflect.TypeOf((*MockImager)(nil).RepositoryTags), repository)
+}
+
 // MockCataloger is a mock of Cataloger interface.
 type MockCataloger struct {
 	ctrl     *gomock.Controller
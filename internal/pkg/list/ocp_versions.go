@@ -0,0 +1,84 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// OCPVersionMatrix lists the OpenShift versions published by a set of catalog sources.
+type OCPVersionMatrix struct {
+	Sources  []CatalogSource `json:"sources"`
+	Versions []OCPVersion    `json:"versions"`
+}
+
+// OCPVersion is an OpenShift version and the catalogs published for it.
+type OCPVersion struct {
+	Version  string    `json:"version"`
+	Catalogs []Catalog `json:"catalogs"`
+}
+
+// OCPVersions lists the tags of the repository of every catalog source and returns the
+// OpenShift versions they publish, oldest first. The Red Hat catalogs are used when no
+// source is given. Sources whose tags cannot be listed are skipped with a warning.
+func (c *CatalogLister) OCPVersions(sources []CatalogSource) (*OCPVersionMatrix, error) {
+	if len(sources) == 0 {
+		sources = DefaultCatalogSources()
+	}
+	c.log.Debugf("Listing OpenShift versions of %d catalog sources...", len(sources))
+
+	var wg sync.WaitGroup
+	tagsBySource := make([][]string, len(sources))
+	errs := make([]error, len(sources))
+	for i, source := range sources {
+		wg.Add(1)
+		go func(i int, source CatalogSource) {
+			defer wg.Done()
+			tagsBySource[i], errs[i] = c.imager.RepositoryTags(source.Repository)
+		}(i, source)
+	}
+	wg.Wait()
+
+	matrix := &OCPVersionMatrix{}
+	versions := make(map[string]*OCPVersion)
+	for i, source := range sources {
+		if errs[i] != nil {
+			c.log.Warnf("Skipping catalog source %s: %v", source.Name, errs[i])
+			continue
+		}
+		matrix.Sources = append(matrix.Sources, source)
+		pattern := source.tagPattern()
+		for _, tag := range tagsBySource[i] {
+			version, ok := versionFromTag(pattern, tag)
+			if !ok {
+				continue
+			}
+			v, ok := versions[version]
+			if !ok {
+				v = &OCPVersion{Version: version}
+				versions[version] = v
+			}
+			v.Catalogs = append(v.Catalogs, Catalog{
+				Reference:   source.Repository + ":" + tag,
+				Source:      source.Name,
+				Label:       source.Label,
+				Description: source.Description,
+			})
+		}
+	}
+	if len(matrix.Sources) == 0 {
+		return nil, fmt.Errorf("failed to list the tags of any catalog source: %w", errs[0])
+	}
+
+	for _, v := range versions {
+		matrix.Versions = append(matrix.Versions, *v)
+	}
+	sort.Slice(matrix.Versions, func(i, j int) bool {
+		vi, _ := parseVersion(matrix.Versions[i].Version)
+		vj, _ := parseVersion(matrix.Versions[j].Version)
+		return vi.LT(vj)
+	})
+
+	c.log.Debugf("Found %d OpenShift versions.", len(matrix.Versions))
+	return matrix, nil
+}
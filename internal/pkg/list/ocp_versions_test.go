@@ -0,0 +1,108 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOCPVersions(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	redhat := CatalogSource{Name: "redhat", Label: "Red Hat Operators", Repository: "registry.redhat.io/redhat/redhat-operator-index"}
+	okd := CatalogSource{Name: "okd", Repository: "quay.io/okd/community-index", TagTemplate: "{major}.{minor}-okd"}
+	broken := CatalogSource{Name: "broken", Repository: "registry.example.com/broken"}
+
+	testCases := []struct {
+		name          string
+		sources       []CatalogSource
+		setupMocks    func(m *mock.MockImager)
+		expected      *OCPVersionMatrix
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:    "Success Case - Versions Sorted Across Sources",
+			sources: []CatalogSource{redhat, okd, broken},
+			setupMocks: func(m *mock.MockImager) {
+				m.EXPECT().RepositoryTags(redhat.Repository).Return([]string{"v4.9", "v4.16", "latest", "v4.16-20250101", "v4.10"}, nil)
+				m.EXPECT().RepositoryTags(okd.Repository).Return([]string{"4.16-okd", "4.17-okd", "v4.17"}, nil)
+				m.EXPECT().RepositoryTags(broken.Repository).Return(nil, errors.New("unauthorized"))
+			},
+			expected: &OCPVersionMatrix{
+				Sources: []CatalogSource{redhat, okd},
+				Versions: []OCPVersion{
+					{Version: "4.9", Catalogs: []Catalog{{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.9", Source: "redhat", Label: "Red Hat Operators"}}},
+					{Version: "4.10", Catalogs: []Catalog{{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.10", Source: "redhat", Label: "Red Hat Operators"}}},
+					{Version: "4.16", Catalogs: []Catalog{
+						{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.16", Source: "redhat", Label: "Red Hat Operators"},
+						{Reference: "quay.io/okd/community-index:4.16-okd", Source: "okd"},
+					}},
+					{Version: "4.17", Catalogs: []Catalog{{Reference: "quay.io/okd/community-index:4.17-okd", Source: "okd"}}},
+				},
+			},
+		},
+		{
+			name:    "Failure Case - No Source Reachable",
+			sources: []CatalogSource{broken},
+			setupMocks: func(m *mock.MockImager) {
+				m.EXPECT().RepositoryTags(broken.Repository).Return(nil, errors.New("unauthorized"))
+			},
+			expectErr:     true,
+			expectedError: "failed to list the tags of any catalog source: unauthorized",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImager := mock.NewMockImager(mockCtrl)
+			lister := NewCatalogLister(logger, nil, mockImager)
+
+			tc.setupMocks(mockImager)
+
+			result, err := lister.OCPVersions(tc.sources)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestCatalogSource_VersionFromTag(t *testing.T) {
+	testCases := []struct {
+		name        string
+		tagTemplate string
+		tag         string
+		expected    string
+		expectedOK  bool
+	}{
+		{name: "Default Template", tag: "v4.16", expected: "4.16", expectedOK: true},
+		{name: "Default Template - Build Tag", tag: "v4.16-20250101", expectedOK: false},
+		{name: "Default Template - Digest Tag", tag: "sha256-abc.sig", expectedOK: false},
+		{name: "Major Minor Template", tagTemplate: "release-{major}.{minor}", tag: "release-4.18", expected: "4.18", expectedOK: true},
+		{name: "Template Without Version", tagTemplate: "latest", tag: "latest", expectedOK: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			source := CatalogSource{Name: "test", Repository: "quay.io/example/index", TagTemplate: tc.tagTemplate}
+			version, ok := source.VersionFromTag(tc.tag)
+			assert.Equal(t, tc.expectedOK, ok)
+			assert.Equal(t, tc.expected, version)
+		})
+	}
+}
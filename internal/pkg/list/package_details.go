@@ -0,0 +1,142 @@
+package list
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// CSV annotations describing how to install an operator.
+const (
+	annotationCapabilities               = "capabilities"
+	annotationSuggestedNamespace         = "operatorframework.io/suggested-namespace"
+	annotationSuggestedNamespaceTemplate = "operatorframework.io/suggested-namespace-template"
+)
+
+// PackageDetails holds the installation metadata of a package, read from the CSV metadata
+// of the head of one of its channels.
+type PackageDetails struct {
+	Name           string   `json:"name"`
+	DisplayName    string   `json:"displayName,omitempty"`
+	Provider       string   `json:"provider,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	DefaultChannel string   `json:"defaultChannel"`
+	Channels       []string `json:"channels,omitempty"`
+	// Channel is the channel the metadata was read from and Head its head bundle.
+	Channel            string                 `json:"channel"`
+	Head               string                 `json:"head"`
+	HeadVersion        string                 `json:"headVersion,omitempty"`
+	InstallModes       []v1alpha1.InstallMode `json:"installModes,omitempty"`
+	SuggestedNamespace string                 `json:"suggestedNamespace,omitempty"`
+	CapabilityLevel    string                 `json:"capabilityLevel,omitempty"`
+	Deprecation        string                 `json:"deprecation,omitempty"`
+}
+
+// PackageDetails returns the install modes, suggested namespace and capability level of a package,
+// read from the head of a channel without pulling the bundle image. The default channel is used
+// when no channel is given.
+func (c *CatalogLister) PackageDetails(catalogRef, pkgName, channelName string) (*PackageDetails, error) {
+	if catalogRef == "" || pkgName == "" {
+		return nil, fmt.Errorf("catalog reference and package name are required")
+	}
+	c.log.Debugf("Showing package %s in catalog %s...", pkgName, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+	return c.packageDetails(cfg, catalogRef, pkgName, channelName)
+}
+
+// packageDetails returns the details of a package of cfg, read from the head of a channel or of
+// the default channel when no channel is given.
+func (c *CatalogLister) packageDetails(cfg *declcfg.DeclarativeConfig, catalogRef, pkgName, channelName string) (*PackageDetails, error) {
+	var pkg *declcfg.Package
+	for i := range cfg.Packages {
+		if cfg.Packages[i].Name == pkgName {
+			pkg = &cfg.Packages[i]
+			break
+		}
+	}
+	if pkg == nil {
+		return nil, fmt.Errorf("package %q not found in catalog %q", pkgName, catalogRef)
+	}
+	if channelName == "" {
+		channelName = pkg.DefaultChannel
+	}
+
+	details := &PackageDetails{
+		Name:           pkg.Name,
+		Description:    pkg.Description,
+		DefaultChannel: pkg.DefaultChannel,
+		Channel:        channelName,
+		Deprecation:    newDeprecationIndex(cfg).message(pkgName, DeprecationPackage, pkgName),
+	}
+	var channel *declcfg.Channel
+	for i := range cfg.Channels {
+		if cfg.Channels[i].Package != pkgName {
+			continue
+		}
+		details.Channels = append(details.Channels, cfg.Channels[i].Name)
+		if cfg.Channels[i].Name == channelName {
+			channel = &cfg.Channels[i]
+		}
+	}
+	if channel == nil {
+		return nil, fmt.Errorf("channel %q for package %q not found", channelName, pkgName)
+	}
+
+	heads, err := ChannelHeads(*channel)
+	if err != nil {
+		c.log.Warnf("%v", err)
+	}
+	if len(heads) == 0 {
+		return nil, fmt.Errorf("cannot determine the head of channel %q of package %q", channelName, pkgName)
+	}
+	details.Head = heads[0]
+
+	head := bundlesByName(cfg, pkgName)[details.Head]
+	details.HeadVersion = bundleVersion(head)
+	if metadata := csvMetadata(head); metadata != nil {
+		details.DisplayName = metadata.DisplayName
+		details.Provider = metadata.Provider.Name
+		if details.Description == "" {
+			details.Description = metadata.Description
+		}
+		details.InstallModes = metadata.InstallModes
+		details.CapabilityLevel = metadata.Annotations[annotationCapabilities]
+		details.SuggestedNamespace = suggestedNamespace(metadata.Annotations)
+	} else {
+		c.log.Warnf("Bundle %s has no CSV metadata, install modes are unknown", details.Head)
+	}
+
+	return details, nil
+}
+
+// SupportsInstallMode reports whether the head of the channel supports an install mode.
+func (d *PackageDetails) SupportsInstallMode(mode v1alpha1.InstallModeType) bool {
+	for _, m := range d.InstallModes {
+		if m.Type == mode {
+			return m.Supported
+		}
+	}
+	return false
+}
+
+// suggestedNamespace returns the namespace a CSV suggests installing the operator in, taken from
+// the suggested-namespace annotation or from the name of the suggested-namespace-template.
+func suggestedNamespace(annotations map[string]string) string {
+	if ns := annotations[annotationSuggestedNamespace]; ns != "" {
+		return ns
+	}
+	var template struct {
+		Metadata struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+	}
+	if err := json.Unmarshal([]byte(annotations[annotationSuggestedNamespaceTemplate]), &template); err != nil {
+		return ""
+	}
+	return template.Metadata.Name
+}
@@ -0,0 +1,217 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// packageDetailsTestConfig returns a catalog whose stable channel head installs in its own namespace only
+// and whose fast channel head supports every install mode.
+func packageDetailsTestConfig() *declcfg.DeclarativeConfig {
+	bundle := func(name, version string, annotations map[string]string, modes []v1alpha1.InstallMode) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:    name,
+			Package: "logging",
+			Properties: []property.Property{
+				property.MustBuildPackage("logging", version),
+				property.MustBuildCSVMetadata(v1alpha1.ClusterServiceVersion{
+					ObjectMeta: metav1.ObjectMeta{Annotations: annotations},
+					Spec: v1alpha1.ClusterServiceVersionSpec{
+						DisplayName:  "Logging",
+						Provider:     v1alpha1.AppLink{Name: "Red Hat"},
+						InstallModes: modes,
+					},
+				}),
+			},
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "logging", DefaultChannel: "stable", Description: "Collects logs."}},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "logging", Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v1.0.0"},
+				{Name: "logging.v1.1.0", Replaces: "logging.v1.0.0"},
+			}},
+			{Name: "fast", Package: "logging", Entries: []declcfg.ChannelEntry{{Name: "logging.v2.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("logging.v1.0.0", "1.0.0", nil, nil),
+			bundle("logging.v1.1.0", "1.1.0",
+				map[string]string{"capabilities": "Seamless Upgrades", "operatorframework.io/suggested-namespace": "openshift-logging"},
+				[]v1alpha1.InstallMode{
+					{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+					{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: false},
+				}),
+			bundle("logging.v2.0.0", "2.0.0",
+				map[string]string{"capabilities": "Full Lifecycle", "operatorframework.io/suggested-namespace-template": `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"logging-fast"}}`},
+				[]v1alpha1.InstallMode{{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: true}}),
+		},
+	}
+}
+
+func TestPackageDetails(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		pkgName       string
+		channelName   string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      *PackageDetails
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:    "Success Case - Default Channel",
+			pkgName: "logging",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(packageDetailsTestConfig(), nil)
+			},
+			expected: &PackageDetails{
+				Name:           "logging",
+				DisplayName:    "Logging",
+				Provider:       "Red Hat",
+				Description:    "Collects logs.",
+				DefaultChannel: "stable",
+				Channels:       []string{"stable", "fast"},
+				Channel:        "stable",
+				Head:           "logging.v1.1.0",
+				HeadVersion:    "1.1.0",
+				InstallModes: []v1alpha1.InstallMode{
+					{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+					{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: false},
+				},
+				SuggestedNamespace: "openshift-logging",
+				CapabilityLevel:    "Seamless Upgrades",
+			},
+		},
+		{
+			name:        "Success Case - Given Channel With Namespace Template",
+			pkgName:     "logging",
+			channelName: "fast",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(packageDetailsTestConfig(), nil)
+			},
+			expected: &PackageDetails{
+				Name:               "logging",
+				DisplayName:        "Logging",
+				Provider:           "Red Hat",
+				Description:        "Collects logs.",
+				DefaultChannel:     "stable",
+				Channels:           []string{"stable", "fast"},
+				Channel:            "fast",
+				Head:               "logging.v2.0.0",
+				HeadVersion:        "2.0.0",
+				InstallModes:       []v1alpha1.InstallMode{{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: true}},
+				SuggestedNamespace: "logging-fast",
+				CapabilityLevel:    "Full Lifecycle",
+			},
+		},
+		{
+			name:    "Failure Case - Package Not Found",
+			pkgName: "non-existent",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(packageDetailsTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "non-existent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name:        "Failure Case - Channel Not Found",
+			pkgName:     "logging",
+			channelName: "beta",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(packageDetailsTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "beta" for package "logging" not found`,
+		},
+		{
+			name:    "Failure Case - Cataloger Error",
+			pkgName: "logging",
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.PackageDetails("test-catalog:latest", tc.pkgName, tc.channelName)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestPackageDetails_SupportsInstallMode(t *testing.T) {
+	details := &PackageDetails{InstallModes: []v1alpha1.InstallMode{
+		{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+		{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: false},
+	}}
+
+	assert.True(t, details.SupportsInstallMode(v1alpha1.InstallModeTypeOwnNamespace))
+	assert.False(t, details.SupportsInstallMode(v1alpha1.InstallModeTypeAllNamespaces))
+	assert.False(t, details.SupportsInstallMode(v1alpha1.InstallModeTypeMultiNamespace))
+}
+
+func TestPackageDetails_BundleObject(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	// Older catalogs only carry the bundle manifests as olm.bundle.object properties.
+	csv := []byte(`{"apiVersion":"operators.coreos.com/v1alpha1","kind":"ClusterServiceVersion",` +
+		`"metadata":{"name":"logging.v1.0.0","annotations":{"operatorframework.io/suggested-namespace":"openshift-logging"}},` +
+		`"spec":{"displayName":"Logging","installModes":[{"type":"OwnNamespace","supported":true},{"type":"AllNamespaces","supported":false}]}}`)
+	crd := []byte(`{"apiVersion":"apiextensions.k8s.io/v1","kind":"CustomResourceDefinition","metadata":{"name":"loggings.example.com"}}`)
+	cfg := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: "logging", DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{{Name: "stable", Package: "logging", Entries: []declcfg.ChannelEntry{{Name: "logging.v1.0.0"}}}},
+		Bundles: []declcfg.Bundle{{
+			Name:    "logging.v1.0.0",
+			Package: "logging",
+			Properties: []property.Property{
+				property.MustBuildPackage("logging", "1.0.0"),
+				property.MustBuildBundleObject(crd),
+				property.MustBuildBundleObject(csv),
+			},
+		}},
+	}
+	mockCataloger := mock.NewMockCataloger(mockCtrl)
+	mockCataloger.EXPECT().CatalogConfig("test-catalog:latest").Return(cfg, nil)
+	lister := NewCatalogLister(log.New("error"), mockCataloger, nil)
+
+	details, err := lister.PackageDetails("test-catalog:latest", "logging", "")
+	require.NoError(t, err)
+	assert.Equal(t, "Logging", details.DisplayName)
+	assert.Equal(t, "openshift-logging", details.SuggestedNamespace)
+	assert.True(t, details.SupportsInstallMode(v1alpha1.InstallModeTypeOwnNamespace))
+	assert.False(t, details.SupportsInstallMode(v1alpha1.InstallModeTypeAllNamespaces))
+}
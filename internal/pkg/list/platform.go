@@ -0,0 +1,51 @@
+package list
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// Prefixes of the CSV labels declaring the architectures and operating systems an operator supports,
+// e.g. operatorframework.io/arch.arm64: supported.
+const (
+	labelArchPrefix = "operatorframework.io/arch."
+	labelOSPrefix   = "operatorframework.io/os."
+	labelSupported  = "supported"
+)
+
+// Platforms OLM assumes when a CSV declares no architecture or operating system label.
+const (
+	defaultArch = "amd64"
+	defaultOS   = "linux"
+)
+
+// platforms returns the sorted architectures and operating systems supported according to the labels
+// of the CSV metadata, defaulting to amd64 and linux like OLM does, including when there is no CSV
+// metadata to read them from.
+func platforms(metadata *property.CSVMetadata) (archs, oses []string) {
+	var labels map[string]string
+	if metadata != nil {
+		labels = metadata.Labels
+	}
+	for label, value := range labels {
+		if value != labelSupported {
+			continue
+		}
+		if arch, ok := strings.CutPrefix(label, labelArchPrefix); ok {
+			archs = append(archs, arch)
+		} else if os, ok := strings.CutPrefix(label, labelOSPrefix); ok {
+			oses = append(oses, os)
+		}
+	}
+	if len(archs) == 0 {
+		archs = []string{defaultArch}
+	}
+	if len(oses) == 0 {
+		oses = []string{defaultOS}
+	}
+	sort.Strings(archs)
+	sort.Strings(oses)
+	return archs, oses
+}
@@ -0,0 +1,77 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// platformTestConfig returns a catalog whose multiarch package gained arm64 support in its latest bundle,
+// and a package without CSV metadata.
+func platformTestConfig() *declcfg.DeclarativeConfig {
+	bundle := func(name, version string, labels map[string]string) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:    name,
+			Package: "multiarch",
+			Properties: []property.Property{
+				property.MustBuildPackage("multiarch", version),
+				property.MustBuildCSVMetadata(v1alpha1.ClusterServiceVersion{ObjectMeta: metav1.ObjectMeta{Labels: labels}}),
+			},
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Name: "multiarch", DefaultChannel: "stable"},
+			{Name: "legacy", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "multiarch", Entries: []declcfg.ChannelEntry{
+				{Name: "multiarch.v1.0.0"},
+				{Name: "multiarch.v1.1.0", Replaces: "multiarch.v1.0.0"},
+			}},
+			{Name: "stable", Package: "legacy", Entries: []declcfg.ChannelEntry{{Name: "legacy.v1.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("multiarch.v1.0.0", "1.0.0", nil),
+			bundle("multiarch.v1.1.0", "1.1.0", map[string]string{
+				"operatorframework.io/arch.arm64":   "supported",
+				"operatorframework.io/arch.amd64":   "supported",
+				"operatorframework.io/arch.s390x":   "unsupported",
+				"operatorframework.io/os.linux":     "supported",
+				"operators.operatorframework.io/ok": "supported",
+			}),
+			{Name: "legacy.v1.0.0", Package: "legacy", Properties: []property.Property{property.MustBuildPackage("legacy", "1.0.0")}},
+		},
+	}
+}
+
+func TestPlatformsInListings(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	mockCataloger := mock.NewMockCataloger(mockCtrl)
+	lister := NewCatalogLister(log.New("error"), mockCataloger, nil)
+	mockCataloger.EXPECT().CatalogConfig("test-catalog:latest").Return(platformTestConfig(), nil).Times(2)
+
+	packages, err := lister.PackagesByCatalog("test-catalog:latest")
+	require.NoError(t, err)
+	assert.Equal(t, []Package{
+		{Name: "multiarch", DefaultChannel: "stable", Architectures: []string{"amd64", "arm64"}, OperatingSystems: []string{"linux"}},
+		{Name: "legacy", DefaultChannel: "stable", Architectures: []string{"amd64"}, OperatingSystems: []string{"linux"}},
+	}, packages, "packages without CSV metadata default to amd64 and linux")
+
+	bundles, err := lister.BundleVersionsByChannel("test-catalog:latest", "multiarch", "stable")
+	require.NoError(t, err)
+	require.Len(t, bundles, 2)
+	assert.Equal(t, []string{"amd64"}, bundles[0].Architectures, "bundles without arch labels default to amd64")
+	assert.Equal(t, []string{"linux"}, bundles[0].OperatingSystems)
+	assert.Equal(t, []string{"amd64", "arm64"}, bundles[1].Architectures)
+}
@@ -0,0 +1,84 @@
+package list
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/itchyny/gojq"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// queryDocument is the input of catalog queries. declcfg.DeclarativeConfig has no JSON tags, so
+// the document spells out the lowercase keys expressions refer to.
+type queryDocument struct {
+	Packages     []declcfg.Package     `json:"packages"`
+	Channels     []declcfg.Channel     `json:"channels"`
+	Bundles      []declcfg.Bundle      `json:"bundles"`
+	Deprecations []declcfg.Deprecation `json:"deprecations"`
+	Others       []declcfg.Meta        `json:"others"`
+}
+
+// Query evaluates a jq expression over the packages, channels, bundles, deprecations and
+// other blobs of a catalog and returns its outputs as generic JSON values. Bundle properties keep
+// their FBC shape, so a property value is reached with .properties[].value.
+func (c *CatalogLister) Query(catalogRef, expr string) ([]any, error) {
+	if catalogRef == "" || expr == "" {
+		return nil, fmt.Errorf("catalog reference and query expression are required")
+	}
+	q, err := gojq.Parse(expr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+	code, err := gojq.Compile(q)
+	if err != nil {
+		return nil, fmt.Errorf("invalid query %q: %w", expr, err)
+	}
+
+	c.log.Debugf("Querying catalog %s with %s...", catalogRef, expr)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.Marshal(queryDocument{
+		Packages:     cfg.Packages,
+		Channels:     cfg.Channels,
+		Bundles:      cfg.Bundles,
+		Deprecations: cfg.Deprecations,
+		Others:       cfg.Others,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode catalog %q: %w", catalogRef, err)
+	}
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("failed to decode catalog %q: %w", catalogRef, err)
+	}
+	for key, value := range doc {
+		// Missing blobs are empty lists rather than null, so that .bundles[] never fails.
+		if value == nil {
+			doc[key] = []any{}
+		}
+	}
+
+	var results []any
+	iter := code.Run(doc)
+	for {
+		v, ok := iter.Next()
+		if !ok {
+			break
+		}
+		if err, ok := v.(error); ok {
+			var haltErr *gojq.HaltError
+			if errors.As(err, &haltErr) && haltErr.Value() == nil {
+				// halt stops the query without an error.
+				break
+			}
+			return nil, fmt.Errorf("failed to evaluate query %q: %w", expr, err)
+		}
+		results = append(results, v)
+	}
+	c.log.Debugf("Query produced %d results", len(results))
+	return results, nil
+}
@@ -0,0 +1,133 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestQuery(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	catalogRef := "registry.example.com/catalog:latest"
+	cfg := &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Schema: declcfg.SchemaPackage, Name: "logging", DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{{
+			Schema:  declcfg.SchemaChannel,
+			Name:    "stable",
+			Package: "logging",
+			Entries: []declcfg.ChannelEntry{
+				{Name: "logging.v1.0.0"},
+				{Name: "logging.v1.1.0", Replaces: "logging.v1.0.0"},
+				{Name: "logging.v1.2.0", SkipRange: ">=1.0.0 <1.2.0"},
+			},
+		}},
+		Bundles: []declcfg.Bundle{{
+			Schema:     declcfg.SchemaBundle,
+			Name:       "logging.v1.2.0",
+			Package:    "logging",
+			Properties: []property.Property{property.MustBuildPackage("logging", "1.2.0")},
+		}},
+	}
+
+	testCases := []struct {
+		name          string
+		expr          string
+		setupMocks    func(c *mock.MockCataloger)
+		expected      []any
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name: "Success Case - Skip Range Without Replaces",
+			expr: "[.channels[].entries[] | select(.skipRange and (.replaces | not)) | .name]",
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []any{[]any{"logging.v1.2.0"}},
+		},
+		{
+			name: "Success Case - Property Values And Empty Blobs",
+			expr: `(.bundles[].properties[] | select(.type == "olm.package") | .value.version), .deprecations`,
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []any{"1.2.0", []any{}},
+		},
+		{
+			name: "Success Case - Full jq Language",
+			expr: `def heads: [.channels[] | .entries[-1].name]; ` +
+				`(reduce .channels[].entries[] as $e (0; . + 1)), ` +
+				`(try error("boom") catch .), ` +
+				`heads, ` +
+				`([.channels[0].entries[] | select(.replaces)] as [$first] | $first.name), ` +
+				`([paths(type == "string")] | length > 0)`,
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []any{3, "boom", []any{"logging.v1.2.0"}, "logging.v1.1.0", true},
+		},
+		{
+			name: "Success Case - Halt",
+			expr: ".packages[0].name, halt, .channels",
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expected: []any{"logging"},
+		},
+		{
+			name: "Failure Case - Evaluation Error",
+			expr: ".packages.name",
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(cfg, nil)
+			},
+			expectErr:     true,
+			expectedError: `failed to evaluate query ".packages.name": expected an object but got: array ([{"defaultChannel":"stabl ...])`,
+		},
+		{
+			name:          "Failure Case - Invalid Expression",
+			expr:          ".packages[",
+			setupMocks:    func(c *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: `invalid query ".packages[": unexpected EOF`,
+		},
+		{
+			name: "Failure Case - Catalog Error",
+			expr: ".packages",
+			setupMocks: func(c *mock.MockCataloger) {
+				c.EXPECT().CatalogConfig(catalogRef).Return(nil, errors.New("pull failed"))
+			},
+			expectErr:     true,
+			expectedError: "pull failed",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mock.NewMockImager(mockCtrl))
+
+			tc.setupMocks(mockCataloger)
+
+			results, err := lister.Query(catalogRef, tc.expr)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, results)
+		})
+	}
+}
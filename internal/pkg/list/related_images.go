@@ -0,0 +1,129 @@
+package list
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/containers/image/v5/docker/reference"
+)
+
+// RelatedImagesFilter selects the bundles whose images are listed. Empty fields do not filter.
+type RelatedImagesFilter struct {
+	Package    string
+	Channel    string
+	MinVersion string
+	MaxVersion string
+}
+
+// RelatedImage is an image referenced by one or more bundles of a catalog, either as
+// the bundle image itself or through its relatedImages.
+type RelatedImage struct {
+	Image   string   `json:"image"`
+	Names   []string `json:"names,omitempty"`
+	Bundles []string `json:"bundles"`
+}
+
+// RelatedImages aggregates the bundle images and related images of the bundles selected
+// by filter, deduplicated and sorted by image reference.
+func (c *CatalogLister) RelatedImages(catalogRef string, filter RelatedImagesFilter) ([]RelatedImage, error) {
+	if catalogRef == "" {
+		return nil, fmt.Errorf("catalog reference is required")
+	}
+	if filter.Channel != "" && filter.Package == "" {
+		return nil, fmt.Errorf("a package is required to filter by channel")
+	}
+	inRange, err := versionFilter(filter.MinVersion, filter.MaxVersion)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Listing related images in catalog %s...", catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+
+	var inChannel map[string]bool
+	if filter.Channel != "" {
+		for _, ch := range cfg.Channels {
+			if ch.Package == filter.Package && ch.Name == filter.Channel {
+				inChannel = make(map[string]bool, len(ch.Entries))
+				for _, entry := range ch.Entries {
+					inChannel[entry.Name] = true
+				}
+				break
+			}
+		}
+		if inChannel == nil {
+			return nil, fmt.Errorf("channel %q for package %q not found", filter.Channel, filter.Package)
+		}
+	}
+
+	images := make(map[string]*RelatedImage)
+	add := func(image, name, bundle string) {
+		if image == "" {
+			return
+		}
+		img, ok := images[image]
+		if !ok {
+			img = &RelatedImage{Image: image}
+			images[image] = img
+		}
+		if name != "" && !slices.Contains(img.Names, name) {
+			img.Names = append(img.Names, name)
+		}
+		if !slices.Contains(img.Bundles, bundle) {
+			img.Bundles = append(img.Bundles, bundle)
+		}
+	}
+
+	found := false
+	for i := range cfg.Bundles {
+		b := &cfg.Bundles[i]
+		if filter.Package != "" && b.Package != filter.Package {
+			continue
+		}
+		found = true
+		if inChannel != nil && !inChannel[b.Name] {
+			continue
+		}
+		if !inRange(bundleVersion(b)) {
+			continue
+		}
+		add(b.Image, "", b.Name)
+		for _, related := range b.RelatedImages {
+			add(related.Image, related.Name, b.Name)
+		}
+	}
+	if filter.Package != "" && !found {
+		return nil, fmt.Errorf("package %q not found in catalog %q", filter.Package, catalogRef)
+	}
+
+	result := make([]RelatedImage, 0, len(images))
+	for _, img := range images {
+		result = append(result, *img)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Image < result[j].Image })
+
+	c.log.Debugf("Found %d related images.", len(result))
+	return result, nil
+}
+
+// MirrorDestination returns the reference an image is mirrored to in mirrorRegistry,
+// keeping its repository path and tag. Images pinned by digest are mirrored by digest,
+// so the destination is the bare repository.
+func MirrorDestination(image, mirrorRegistry string) (string, error) {
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %q: %w", image, err)
+	}
+	dest := strings.TrimSuffix(mirrorRegistry, "/") + "/" + reference.Path(named)
+	if _, ok := named.(reference.Digested); ok {
+		return dest, nil
+	}
+	if tagged, ok := named.(reference.Tagged); ok {
+		return dest + ":" + tagged.Tag(), nil
+	}
+	return dest, nil
+}
@@ -0,0 +1,183 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// relatedImagesTestConfig returns the graph test catalog with related images on
+// every bundle, including an operator image shared by two bundles.
+func relatedImagesTestConfig() *declcfg.DeclarativeConfig {
+	cfg := graphTestConfig()
+	for i := range cfg.Bundles {
+		operatorImage := "quay.io/example/operator@sha256:aaaa"
+		if i >= 2 {
+			operatorImage = "quay.io/example/operator@sha256:bbbb"
+		}
+		cfg.Bundles[i].RelatedImages = []declcfg.RelatedImage{
+			{Image: cfg.Bundles[i].Image},
+			{Name: "operator", Image: operatorImage},
+		}
+	}
+	return cfg
+}
+
+func TestRelatedImages(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		filter        RelatedImagesFilter
+		setupMocks    func(m *mock.MockCataloger)
+		expected      []RelatedImage
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:   "Success Case - Version Range",
+			filter: RelatedImagesFilter{Package: "pkg1", MinVersion: "1.1.0", MaxVersion: "v1.1.1"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(relatedImagesTestConfig(), nil)
+			},
+			expected: []RelatedImage{
+				{Image: "quay.io/example/operator@sha256:aaaa", Names: []string{"operator"}, Bundles: []string{"pkg1.v1.1.0"}},
+				{Image: "quay.io/example/operator@sha256:bbbb", Names: []string{"operator"}, Bundles: []string{"pkg1.v1.1.1"}},
+				{Image: "quay.io/example/pkg1.v1.1.0", Bundles: []string{"pkg1.v1.1.0"}},
+				{Image: "quay.io/example/pkg1.v1.1.1", Bundles: []string{"pkg1.v1.1.1"}},
+			},
+		},
+		{
+			name:   "Success Case - Channel",
+			filter: RelatedImagesFilter{Package: "pkg1", Channel: "fast"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(relatedImagesTestConfig(), nil)
+			},
+			expected: []RelatedImage{
+				{Image: "quay.io/example/operator@sha256:bbbb", Names: []string{"operator"}, Bundles: []string{"pkg1.v1.2.0"}},
+				{Image: "quay.io/example/pkg1.v1.2.0", Bundles: []string{"pkg1.v1.2.0"}},
+			},
+		},
+		{
+			name:   "Success Case - Deduplicated Across Bundles",
+			filter: RelatedImagesFilter{MaxVersion: "1.1.0"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(relatedImagesTestConfig(), nil)
+			},
+			expected: []RelatedImage{
+				{Image: "quay.io/example/operator@sha256:aaaa", Names: []string{"operator"}, Bundles: []string{"pkg1.v1.0.0", "pkg1.v1.1.0"}},
+				{Image: "quay.io/example/pkg1.v1.0.0", Bundles: []string{"pkg1.v1.0.0"}},
+				{Image: "quay.io/example/pkg1.v1.1.0", Bundles: []string{"pkg1.v1.1.0"}},
+			},
+		},
+		{
+			name:   "Failure Case - Channel Not Found",
+			filter: RelatedImagesFilter{Package: "pkg1", Channel: "nonexistent"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(relatedImagesTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `channel "nonexistent" for package "pkg1" not found`,
+		},
+		{
+			name:   "Failure Case - Package Not Found",
+			filter: RelatedImagesFilter{Package: "nonexistent"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(relatedImagesTestConfig(), nil)
+			},
+			expectErr:     true,
+			expectedError: `package "nonexistent" not found in catalog "test-catalog:latest"`,
+		},
+		{
+			name:   "Failure Case - CatalogConfig returns error",
+			filter: RelatedImagesFilter{Package: "pkg1"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "some catalog error",
+		},
+		{
+			name:          "Failure Case - Channel Without Package",
+			filter:        RelatedImagesFilter{Channel: "stable"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "a package is required to filter by channel",
+		},
+		{
+			name:          "Failure Case - Inverted Version Range",
+			filter:        RelatedImagesFilter{MinVersion: "1.2.0", MaxVersion: "1.0.0"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "minimum version 1.2.0 is greater than maximum version 1.0.0",
+		},
+		{
+			name:          "Failure Case - Invalid Version",
+			filter:        RelatedImagesFilter{MinVersion: "latest"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: `invalid minimum version "latest"`,
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.RelatedImages("test-catalog:latest", tc.filter)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
+
+func TestMirrorDestination(t *testing.T) {
+	testCases := []struct {
+		name     string
+		image    string
+		expected string
+	}{
+		{
+			name:     "Digest",
+			image:    "registry.redhat.io/openshift-logging/cluster-logging-rhel9-operator@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11",
+			expected: "mirror.example.com:5000/openshift-logging/cluster-logging-rhel9-operator",
+		},
+		{
+			name:     "Tag",
+			image:    "quay.io/example/operator:v1.0.0",
+			expected: "mirror.example.com:5000/example/operator:v1.0.0",
+		},
+		{
+			name:     "Docker Hub",
+			image:    "busybox",
+			expected: "mirror.example.com:5000/library/busybox",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			dest, err := MirrorDestination(tc.image, "mirror.example.com:5000/")
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, dest)
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package list
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+// Scores given to a query term depending on the field it matches, the best match of a term wins.
+const (
+	scoreNameExact    = 100
+	scoreNamePrefix   = 60
+	scoreNameContains = 40
+	scoreDisplayName  = 30
+	scoreKeyword      = 25
+	scoreCategory     = 20
+	scoreProvider     = 15
+	scoreDescription  = 10
+)
+
+// Searchable fields, reported in the order of their score.
+const (
+	fieldName        = "name"
+	fieldDisplayName = "displayName"
+	fieldKeywords    = "keywords"
+	fieldCategories  = "categories"
+	fieldProvider    = "provider"
+	fieldDescription = "description"
+)
+
+// SearchResult is a package matching a search query.
+type SearchResult struct {
+	Catalog        string   `json:"catalog"`
+	Package        string   `json:"package"`
+	DisplayName    string   `json:"displayName,omitempty"`
+	Provider       string   `json:"provider,omitempty"`
+	DefaultChannel string   `json:"defaultChannel"`
+	Score          int      `json:"score"`
+	MatchedFields  []string `json:"matchedFields"`
+}
+
+// searchDocument holds the searchable fields of a package.
+type searchDocument struct {
+	name        string
+	displayName string
+	description string
+	provider    string
+	keywords    []string
+	categories  []string
+}
+
+// Search looks for packages matching every term of query in the given catalogs. Package
+// names, display names, descriptions, keywords, providers and categories are matched, using
+// the package description and the CSV metadata of the default channel head. Results are
+// ranked by relevance.
+func (c *CatalogLister) Search(query string, catalogRefs []string) ([]SearchResult, error) {
+	terms := strings.Fields(strings.ToLower(query))
+	if len(terms) == 0 {
+		return nil, fmt.Errorf("a search query is required")
+	}
+	c.log.Debugf("Searching for %q in %d catalogs...", query, len(catalogRefs))
+	catalogs, err := c.loadCatalogs(catalogRefs)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []SearchResult
+	for _, catalog := range catalogs {
+		results = append(results, searchCatalog(catalog.cfg, catalog.ref, terms)...)
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].Score != results[j].Score {
+			return results[i].Score > results[j].Score
+		}
+		if results[i].Package != results[j].Package {
+			return results[i].Package < results[j].Package
+		}
+		return results[i].Catalog < results[j].Catalog
+	})
+
+	c.log.Debugf("Found %d matching packages.", len(results))
+	return results, nil
+}
+
+// searchCatalog returns the packages of a catalog matching all the terms.
+func searchCatalog(cfg *declcfg.DeclarativeConfig, catalogRef string, terms []string) []SearchResult {
+	var results []SearchResult
+	bundles := bundlesByPackage(cfg)
+	for _, pkg := range cfg.Packages {
+		doc := newSearchDocument(cfg, pkg, bundles[pkg.Name])
+		score, fields := doc.match(terms)
+		if score == 0 {
+			continue
+		}
+		results = append(results, SearchResult{
+			Catalog:        catalogRef,
+			Package:        pkg.Name,
+			DisplayName:    doc.displayName,
+			Provider:       doc.provider,
+			DefaultChannel: pkg.DefaultChannel,
+			Score:          score,
+			MatchedFields:  fields,
+		})
+	}
+	return results
+}
+
+func newSearchDocument(cfg *declcfg.DeclarativeConfig, pkg declcfg.Package, bundles map[string]*declcfg.Bundle) searchDocument {
+	doc := searchDocument{name: pkg.Name, description: pkg.Description}
+	metadata := csvMetadata(defaultChannelHead(cfg, pkg, bundles))
+	if metadata == nil {
+		return doc
+	}
+	doc.displayName = metadata.DisplayName
+	doc.provider = metadata.Provider.Name
+	doc.keywords = metadata.Keywords
+	if doc.description == "" {
+		doc.description = metadata.Description
+	}
+	for _, category := range strings.Split(metadata.Annotations["categories"], ",") {
+		if category = strings.TrimSpace(category); category != "" {
+			doc.categories = append(doc.categories, category)
+		}
+	}
+	return doc
+}
+
+// match scores the document against the lower-cased terms. A score of zero means
+// that at least one term did not match any field.
+func (d searchDocument) match(terms []string) (int, []string) {
+	total := 0
+	matched := map[string]bool{}
+	for _, term := range terms {
+		best, field := d.matchTerm(term)
+		if best == 0 {
+			return 0, nil
+		}
+		total += best
+		matched[field] = true
+	}
+
+	var fields []string
+	for _, field := range []string{fieldName, fieldDisplayName, fieldKeywords, fieldCategories, fieldProvider, fieldDescription} {
+		if matched[field] {
+			fields = append(fields, field)
+		}
+	}
+	return total, fields
+}
+
+// matchTerm returns the best score of a single term and the field it matched.
+func (d searchDocument) matchTerm(term string) (int, string) {
+	name := strings.ToLower(d.name)
+	switch {
+	case name == term:
+		return scoreNameExact, fieldName
+	case strings.HasPrefix(name, term):
+		return scoreNamePrefix, fieldName
+	case strings.Contains(name, term):
+		return scoreNameContains, fieldName
+	case strings.Contains(strings.ToLower(d.displayName), term):
+		return scoreDisplayName, fieldDisplayName
+	case containsFold(d.keywords, term):
+		return scoreKeyword, fieldKeywords
+	case containsFold(d.categories, term):
+		return scoreCategory, fieldCategories
+	case strings.Contains(strings.ToLower(d.provider), term):
+		return scoreProvider, fieldProvider
+	case strings.Contains(strings.ToLower(d.description), term):
+		return scoreDescription, fieldDescription
+	}
+	return 0, ""
+}
+
+// containsFold reports whether any value contains the lower-cased term, ignoring case.
+func containsFold(values []string, term string) bool {
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), term) {
+			return true
+		}
+	}
+	return false
+}
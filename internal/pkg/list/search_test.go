@@ -0,0 +1,163 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// searchTestConfig returns a catalog with two packages described by the CSV metadata of their channel heads.
+func searchTestConfig() *declcfg.DeclarativeConfig {
+	bundle := func(pkg, name string, csv v1alpha1.ClusterServiceVersion) declcfg.Bundle {
+		return declcfg.Bundle{
+			Name:    name,
+			Package: pkg,
+			Properties: []property.Property{
+				property.MustBuildPackage(pkg, "1.0.0"),
+				property.MustBuildCSVMetadata(csv),
+			},
+		}
+	}
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{
+			{Name: "cluster-logging", DefaultChannel: "stable"},
+			{Name: "loki-operator", DefaultChannel: "stable"},
+		},
+		Channels: []declcfg.Channel{
+			{Name: "stable", Package: "cluster-logging", Entries: []declcfg.ChannelEntry{{Name: "cluster-logging.v1.0.0"}}},
+			{Name: "stable", Package: "loki-operator", Entries: []declcfg.ChannelEntry{{Name: "loki-operator.v1.0.0"}}},
+		},
+		Bundles: []declcfg.Bundle{
+			bundle("cluster-logging", "cluster-logging.v1.0.0", v1alpha1.ClusterServiceVersion{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"categories": "OpenShift Optional, Logging & Tracing"}},
+				Spec: v1alpha1.ClusterServiceVersionSpec{
+					DisplayName: "Red Hat OpenShift Logging",
+					Description: "Collects and forwards cluster logs.",
+					Keywords:    []string{"elasticsearch", "logging"},
+				},
+			}),
+			bundle("loki-operator", "loki-operator.v1.0.0", v1alpha1.ClusterServiceVersion{
+				ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"categories": "Logging & Tracing"}},
+				Spec: v1alpha1.ClusterServiceVersionSpec{
+					DisplayName: "Loki Operator",
+					Description: "Log storage for logging stacks.",
+				},
+			}),
+		},
+	}
+}
+
+func TestSearch(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		query         string
+		catalogs      []string
+		setupMocks    func(m *mock.MockCataloger)
+		expected      []SearchResult
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:     "Success Case - Ranked Results",
+			query:    "logging",
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(searchTestConfig(), nil)
+			},
+			expected: []SearchResult{
+				{Catalog: "test-catalog:latest", Package: "cluster-logging", DisplayName: "Red Hat OpenShift Logging", DefaultChannel: "stable", Score: scoreNameContains, MatchedFields: []string{fieldName}},
+				{Catalog: "test-catalog:latest", Package: "loki-operator", DisplayName: "Loki Operator", DefaultChannel: "stable", Score: scoreCategory, MatchedFields: []string{fieldCategories}},
+			},
+		},
+		{
+			name:     "Success Case - Every Term Must Match",
+			query:    "loki storage",
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(searchTestConfig(), nil)
+			},
+			expected: []SearchResult{
+				{Catalog: "test-catalog:latest", Package: "loki-operator", DisplayName: "Loki Operator", DefaultChannel: "stable", Score: scoreNamePrefix + scoreDescription, MatchedFields: []string{fieldName, fieldDescription}},
+			},
+		},
+		{
+			name:     "Success Case - Keyword Across Catalogs",
+			query:    "Elasticsearch",
+			catalogs: []string{"test-catalog:latest", "broken-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(searchTestConfig(), nil)
+				m.EXPECT().CatalogConfig("broken-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expected: []SearchResult{
+				{Catalog: "test-catalog:latest", Package: "cluster-logging", DisplayName: "Red Hat OpenShift Logging", DefaultChannel: "stable", Score: scoreKeyword, MatchedFields: []string{fieldKeywords}},
+			},
+		},
+		{
+			name:     "Success Case - No Match",
+			query:    "database",
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(searchTestConfig(), nil)
+			},
+		},
+		{
+			name:     "Failure Case - No Catalog Loaded",
+			query:    "logging",
+			catalogs: []string{"test-catalog:latest"},
+			setupMocks: func(m *mock.MockCataloger) {
+				m.EXPECT().CatalogConfig("test-catalog:latest").Return(nil, errors.New("some catalog error"))
+			},
+			expectErr:     true,
+			expectedError: "failed to load any catalog: some catalog error",
+		},
+		{
+			name:          "Failure Case - Empty Query",
+			query:         "  ",
+			catalogs:      []string{"test-catalog:latest"},
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "a search query is required",
+		},
+		{
+			name:          "Failure Case - No Catalogs",
+			query:         "logging",
+			setupMocks:    func(m *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "at least one catalog reference is required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			tc.setupMocks(mockCataloger)
+
+			result, err := lister.Search(tc.query, tc.catalogs)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package list
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+)
+
+const (
+	// GlobalOperatorsNamespace is the OpenShift namespace whose OperatorGroup targets all namespaces.
+	GlobalOperatorsNamespace = "openshift-operators"
+	// MarketplaceNamespace is the OpenShift namespace holding the cluster wide catalog sources.
+	MarketplaceNamespace = "openshift-marketplace"
+)
+
+// clusterCatalogSources maps the repositories of the default catalogs to the name of the
+// CatalogSource serving them on OpenShift clusters.
+var clusterCatalogSources = map[string]string{
+	"registry.redhat.io/redhat/redhat-operator-index":    "redhat-operators",
+	"registry.redhat.io/redhat/certified-operator-index": "certified-operators",
+	"registry.redhat.io/redhat/community-operator-index": "community-operators",
+	"registry.redhat.io/redhat/redhat-marketplace-index": "redhat-marketplace",
+}
+
+// SubscriptionOptions describes the installation of a package through OLM.
+type SubscriptionOptions struct {
+	Package string
+	// Channel is the channel to subscribe to, the default channel of the package when empty.
+	Channel     string
+	StartingCSV string
+	// Namespace is the namespace to install the operator in, the namespace suggested by the
+	// package when empty.
+	Namespace string
+	// Source is the name of the CatalogSource serving the catalog, derived from the catalog
+	// reference when empty, and distinct from the cluster catalog sources with CatalogSource.
+	Source string
+	// Approval is the install plan approval, Automatic when empty.
+	Approval string
+	// CatalogSource adds the CatalogSource serving the catalog to the manifests.
+	CatalogSource bool
+}
+
+// Manifest is a Kubernetes object, with the few fields of the OLM APIs that lumen sets.
+type Manifest struct {
+	APIVersion string           `json:"apiVersion"`
+	Kind       string           `json:"kind"`
+	Metadata   ManifestMetadata `json:"metadata"`
+	Spec       any              `json:"spec,omitempty"`
+}
+
+// ManifestMetadata is the metadata of a Manifest.
+type ManifestMetadata struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// OperatorGroupSpec is the spec of an OperatorGroup. No target namespaces targets all namespaces.
+type OperatorGroupSpec struct {
+	TargetNamespaces []string `json:"targetNamespaces,omitempty"`
+}
+
+// SubscriptionSpec is the spec of a Subscription.
+type SubscriptionSpec struct {
+	Channel             string `json:"channel"`
+	InstallPlanApproval string `json:"installPlanApproval"`
+	Name                string `json:"name"`
+	Source              string `json:"source"`
+	SourceNamespace     string `json:"sourceNamespace"`
+	StartingCSV         string `json:"startingCSV,omitempty"`
+}
+
+// CatalogSourceSpec is the spec of a CatalogSource serving a catalog image.
+type CatalogSourceSpec struct {
+	SourceType  string `json:"sourceType"`
+	Image       string `json:"image"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// SubscriptionManifests returns the manifests installing a package from a catalog: the Namespace,
+// an OperatorGroup matching the install modes of the channel head and the Subscription, preceded
+// by the CatalogSource when requested. The Namespace and OperatorGroup are left out for the
+// openshift-operators namespace, which already targets all namespaces.
+func (c *CatalogLister) SubscriptionManifests(catalogRef string, opts SubscriptionOptions) ([]Manifest, error) {
+	if catalogRef == "" || opts.Package == "" {
+		return nil, fmt.Errorf("catalog reference and package name are required")
+	}
+	approval := v1alpha1.Approval(opts.Approval)
+	if approval == "" {
+		approval = v1alpha1.ApprovalAutomatic
+	}
+	if approval != v1alpha1.ApprovalAutomatic && approval != v1alpha1.ApprovalManual {
+		return nil, fmt.Errorf("invalid install plan approval %q, expected %s or %s", opts.Approval, v1alpha1.ApprovalAutomatic, v1alpha1.ApprovalManual)
+	}
+	c.log.Debugf("Generating subscription manifests for package %s in catalog %s...", opts.Package, catalogRef)
+	cfg, err := c.cataloger.CatalogConfig(catalogRef)
+	if err != nil {
+		return nil, err
+	}
+	details, err := c.packageDetails(cfg, catalogRef, opts.Package, opts.Channel)
+	if err != nil {
+		return nil, err
+	}
+	if details.Deprecation != "" {
+		c.log.Warnf("Package %s is deprecated: %s", details.Name, details.Deprecation)
+	}
+
+	if opts.StartingCSV != "" {
+		found := slices.ContainsFunc(cfg.Channels, func(ch declcfg.Channel) bool {
+			return ch.Package == details.Name && ch.Name == details.Channel &&
+				slices.ContainsFunc(ch.Entries, func(entry declcfg.ChannelEntry) bool { return entry.Name == opts.StartingCSV })
+		})
+		if !found {
+			return nil, fmt.Errorf("bundle %q not found in channel %q of package %q", opts.StartingCSV, details.Channel, details.Name)
+		}
+	}
+
+	namespace := opts.Namespace
+	if namespace == "" {
+		namespace = details.SuggestedNamespace
+	}
+	allNamespaces := details.SupportsInstallMode(v1alpha1.InstallModeTypeAllNamespaces)
+	if namespace == "" {
+		namespace = details.Name
+		if allNamespaces {
+			namespace = GlobalOperatorsNamespace
+		}
+	}
+
+	source := opts.Source
+	if source == "" {
+		source = catalogSourceName(catalogRef, opts.CatalogSource)
+	}
+	var manifests []Manifest
+	if opts.CatalogSource {
+		manifests = append(manifests, Manifest{
+			APIVersion: "operators.coreos.com/v1alpha1",
+			Kind:       "CatalogSource",
+			Metadata:   ManifestMetadata{Name: source, Namespace: MarketplaceNamespace},
+			Spec:       CatalogSourceSpec{SourceType: string(v1alpha1.SourceTypeGrpc), Image: catalogRef, DisplayName: source},
+		})
+	}
+
+	switch {
+	case namespace == GlobalOperatorsNamespace:
+		if !allNamespaces {
+			return nil, fmt.Errorf("package %q does not support the %s install mode required by namespace %q", details.Name, v1alpha1.InstallModeTypeAllNamespaces, namespace)
+		}
+	case allNamespaces || details.SupportsInstallMode(v1alpha1.InstallModeTypeOwnNamespace):
+		spec := OperatorGroupSpec{}
+		if !allNamespaces {
+			spec.TargetNamespaces = []string{namespace}
+		}
+		manifests = append(manifests,
+			Manifest{APIVersion: "v1", Kind: "Namespace", Metadata: ManifestMetadata{Name: namespace}},
+			Manifest{
+				APIVersion: "operators.coreos.com/v1",
+				Kind:       "OperatorGroup",
+				Metadata:   ManifestMetadata{Name: namespace, Namespace: namespace},
+				Spec:       spec,
+			},
+		)
+	default:
+		return nil, fmt.Errorf("package %q supports neither the %s nor the %s install mode", details.Name, v1alpha1.InstallModeTypeAllNamespaces, v1alpha1.InstallModeTypeOwnNamespace)
+	}
+
+	manifests = append(manifests, Manifest{
+		APIVersion: "operators.coreos.com/v1alpha1",
+		Kind:       "Subscription",
+		Metadata:   ManifestMetadata{Name: details.Name, Namespace: namespace},
+		Spec: SubscriptionSpec{
+			Channel:             details.Channel,
+			InstallPlanApproval: string(approval),
+			Name:                details.Name,
+			Source:              source,
+			SourceNamespace:     MarketplaceNamespace,
+			StartingCSV:         opts.StartingCSV,
+		},
+	})
+
+	c.log.Debugf("Generated %d manifests for package %s", len(manifests), details.Name)
+	return manifests, nil
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// catalogSourceName returns the name of the CatalogSource serving a catalog. The default catalogs
+// are served by the cluster catalog sources, unless a CatalogSource of our own is created, which
+// is named after the catalog repository and never after a cluster catalog source so that both can
+// coexist in the marketplace namespace.
+func catalogSourceName(catalogRef string, own bool) string {
+	repository, _, _ := strings.Cut(catalogRef, "@")
+	if i := strings.LastIndex(repository, ":"); i > strings.LastIndex(repository, "/") {
+		repository = repository[:i]
+	}
+	if name, ok := clusterCatalogSources[repository]; ok && !own {
+		return name
+	}
+	name := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(path.Base(repository)), "-"), "-")
+	for _, clusterName := range clusterCatalogSources {
+		if name == clusterName {
+			return name + "-catalog"
+		}
+	}
+	return name
+}
@@ -0,0 +1,201 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestSubscriptionManifests(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	redhatCatalog := "registry.redhat.io/redhat/redhat-operator-index:v4.18"
+	mirrorCatalog := "registry.example.com/mirror/My_Catalog@sha256:0123"
+
+	testCases := []struct {
+		name          string
+		catalogRef    string
+		opts          SubscriptionOptions
+		expected      []Manifest
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:       "Success Case - Own Namespace In Suggested Namespace",
+			catalogRef: redhatCatalog,
+			opts:       SubscriptionOptions{Package: "logging"},
+			expected: []Manifest{
+				{APIVersion: "v1", Kind: "Namespace", Metadata: ManifestMetadata{Name: "openshift-logging"}},
+				{
+					APIVersion: "operators.coreos.com/v1",
+					Kind:       "OperatorGroup",
+					Metadata:   ManifestMetadata{Name: "openshift-logging", Namespace: "openshift-logging"},
+					Spec:       OperatorGroupSpec{TargetNamespaces: []string{"openshift-logging"}},
+				},
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "Subscription",
+					Metadata:   ManifestMetadata{Name: "logging", Namespace: "openshift-logging"},
+					Spec: SubscriptionSpec{
+						Channel:             "stable",
+						InstallPlanApproval: "Automatic",
+						Name:                "logging",
+						Source:              "redhat-operators",
+						SourceNamespace:     "openshift-marketplace",
+					},
+				},
+			},
+		},
+		{
+			name:       "Success Case - All Namespaces With Catalog Source",
+			catalogRef: mirrorCatalog,
+			opts:       SubscriptionOptions{Package: "logging", Channel: "fast", StartingCSV: "logging.v2.0.0", Approval: "Manual", CatalogSource: true},
+			expected: []Manifest{
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "CatalogSource",
+					Metadata:   ManifestMetadata{Name: "my-catalog", Namespace: "openshift-marketplace"},
+					Spec:       CatalogSourceSpec{SourceType: "grpc", Image: mirrorCatalog, DisplayName: "my-catalog"},
+				},
+				{APIVersion: "v1", Kind: "Namespace", Metadata: ManifestMetadata{Name: "logging-fast"}},
+				{
+					APIVersion: "operators.coreos.com/v1",
+					Kind:       "OperatorGroup",
+					Metadata:   ManifestMetadata{Name: "logging-fast", Namespace: "logging-fast"},
+					Spec:       OperatorGroupSpec{},
+				},
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "Subscription",
+					Metadata:   ManifestMetadata{Name: "logging", Namespace: "logging-fast"},
+					Spec: SubscriptionSpec{
+						Channel:             "fast",
+						InstallPlanApproval: "Manual",
+						Name:                "logging",
+						Source:              "my-catalog",
+						SourceNamespace:     "openshift-marketplace",
+						StartingCSV:         "logging.v2.0.0",
+					},
+				},
+			},
+		},
+		{
+			name:       "Success Case - Global Operators Namespace",
+			catalogRef: redhatCatalog,
+			opts:       SubscriptionOptions{Package: "logging", Channel: "fast", Namespace: "openshift-operators", Source: "mirror-operators"},
+			expected: []Manifest{
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "Subscription",
+					Metadata:   ManifestMetadata{Name: "logging", Namespace: "openshift-operators"},
+					Spec: SubscriptionSpec{
+						Channel:             "fast",
+						InstallPlanApproval: "Automatic",
+						Name:                "logging",
+						Source:              "mirror-operators",
+						SourceNamespace:     "openshift-marketplace",
+					},
+				},
+			},
+		},
+		{
+			name:       "Success Case - Catalog Source For A Default Catalog",
+			catalogRef: redhatCatalog,
+			opts:       SubscriptionOptions{Package: "logging", Channel: "fast", Namespace: "openshift-operators", CatalogSource: true},
+			expected: []Manifest{
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "CatalogSource",
+					Metadata:   ManifestMetadata{Name: "redhat-operator-index", Namespace: "openshift-marketplace"},
+					Spec:       CatalogSourceSpec{SourceType: "grpc", Image: redhatCatalog, DisplayName: "redhat-operator-index"},
+				},
+				{
+					APIVersion: "operators.coreos.com/v1alpha1",
+					Kind:       "Subscription",
+					Metadata:   ManifestMetadata{Name: "logging", Namespace: "openshift-operators"},
+					Spec: SubscriptionSpec{
+						Channel:             "fast",
+						InstallPlanApproval: "Automatic",
+						Name:                "logging",
+						Source:              "redhat-operator-index",
+						SourceNamespace:     "openshift-marketplace",
+					},
+				},
+			},
+		},
+		{
+			name:          "Failure Case - Global Operators Namespace Not Supported",
+			catalogRef:    redhatCatalog,
+			opts:          SubscriptionOptions{Package: "logging", Namespace: "openshift-operators"},
+			expectErr:     true,
+			expectedError: `package "logging" does not support the AllNamespaces install mode required by namespace "openshift-operators"`,
+		},
+		{
+			name:          "Failure Case - Starting CSV Not In Channel",
+			catalogRef:    redhatCatalog,
+			opts:          SubscriptionOptions{Package: "logging", StartingCSV: "logging.v2.0.0"},
+			expectErr:     true,
+			expectedError: `bundle "logging.v2.0.0" not found in channel "stable" of package "logging"`,
+		},
+		{
+			name:          "Failure Case - Channel Not Found",
+			catalogRef:    redhatCatalog,
+			opts:          SubscriptionOptions{Package: "logging", Channel: "beta"},
+			expectErr:     true,
+			expectedError: `channel "beta" for package "logging" not found`,
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mock.NewMockImager(mockCtrl))
+
+			mockCataloger.EXPECT().CatalogConfig(tc.catalogRef).Return(packageDetailsTestConfig(), nil)
+
+			result, err := lister.SubscriptionManifests(tc.catalogRef, tc.opts)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				assert.Nil(t, result)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+
+	t.Run("Failure Case - Invalid Approval", func(t *testing.T) {
+		lister := NewCatalogLister(logger, mock.NewMockCataloger(mockCtrl), mock.NewMockImager(mockCtrl))
+
+		_, err := lister.SubscriptionManifests(redhatCatalog, SubscriptionOptions{Package: "logging", Approval: "manual"})
+		assert.EqualError(t, err, `invalid install plan approval "manual", expected Automatic or Manual`)
+	})
+}
+
+func TestCatalogSourceName(t *testing.T) {
+	testCases := []struct {
+		catalogRef string
+		own        bool
+		expected   string
+	}{
+		{catalogRef: "registry.redhat.io/redhat/redhat-operator-index:v4.18", expected: "redhat-operators"},
+		{catalogRef: "registry.redhat.io/redhat/certified-operator-index@sha256:0123", expected: "certified-operators"},
+		{catalogRef: "registry.redhat.io/redhat/redhat-operator-index:v4.18", own: true, expected: "redhat-operator-index"},
+		{catalogRef: "registry.example.com:5000/mirror/My_Catalog:v1", expected: "my-catalog"},
+		{catalogRef: "registry.example.com/mirror/redhat-operators:v4.18", own: true, expected: "redhat-operators-catalog"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.catalogRef, func(t *testing.T) {
+			assert.Equal(t, tc.expected, catalogSourceName(tc.catalogRef, tc.own))
+		})
+	}
+}
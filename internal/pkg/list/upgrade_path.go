@@ -0,0 +1,101 @@
+package list
+
+import (
+	"fmt"
+)
+
+// UpgradePath is the sequence of upgrades OLM takes from an installed bundle to a target bundle.
+type UpgradePath struct {
+	Package string      `json:"package"`
+	Channel string      `json:"channel"`
+	From    string      `json:"from"`
+	To      string      `json:"to"`
+	Hops    []GraphEdge `json:"hops"`
+}
+
+// UpgradePath computes the shortest upgrade path from the bundle named from to the
+// bundle named to in a channel of a package. When to is empty, the channel head is the target.
+func (c *CatalogLister) UpgradePath(catalogRef, pkgName, channelName, from, to string) (*UpgradePath, error) {
+	if catalogRef == "" || pkgName == "" || channelName == "" || from == "" {
+		return nil, fmt.Errorf("catalog reference, package name, channel name, and starting bundle are required")
+	}
+	c.log.Debugf("Computing upgrade path from %s in channel %s of package %s, catalog %s...", from, channelName, pkgName, catalogRef)
+	graph, err := c.UpgradeGraph(catalogRef, pkgName, channelName)
+	if err != nil {
+		return nil, err
+	}
+	ch := graph.Channels[0]
+
+	if to == "" {
+		if len(ch.Heads) != 1 {
+			return nil, fmt.Errorf("channel %q has %d heads, a target bundle is required", channelName, len(ch.Heads))
+		}
+		to = ch.Heads[0]
+	}
+
+	hops, err := ShortestUpgradePath(ch, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	c.log.Debugf("Found upgrade path with %d hops.", len(hops))
+	return &UpgradePath{
+		Package: pkgName,
+		Channel: channelName,
+		From:    from,
+		To:      to,
+		Hops:    hops,
+	}, nil
+}
+
+// ShortestUpgradePath walks the replaces, skips and skipRange edges of a channel graph
+// and returns the fewest hops leading from the bundle named from to the bundle named to.
+// The starting bundle does not need to be a channel entry, as long as an entry
+// replaces or skips it. An empty path is returned when from and to are the same bundle.
+func ShortestUpgradePath(ch GraphChannel, from, to string) ([]GraphEdge, error) {
+	adjacency := make(map[string][]GraphEdge)
+	known := make(map[string]bool)
+	for _, node := range ch.Nodes {
+		known[node] = true
+	}
+	for _, edge := range ch.Edges {
+		adjacency[edge.From] = append(adjacency[edge.From], edge)
+		known[edge.From] = true
+	}
+
+	if !known[from] {
+		return nil, fmt.Errorf("bundle %q is not part of the upgrade graph of channel %q", from, ch.Name)
+	}
+	if !known[to] {
+		return nil, fmt.Errorf("bundle %q is not part of the upgrade graph of channel %q", to, ch.Name)
+	}
+	if from == to {
+		return []GraphEdge{}, nil
+	}
+
+	// Breadth-first search, remembering the edge used to reach each bundle.
+	via := map[string]GraphEdge{}
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, edge := range adjacency[current] {
+			if visited[edge.To] {
+				continue
+			}
+			visited[edge.To] = true
+			via[edge.To] = edge
+			if edge.To == to {
+				var hops []GraphEdge
+				for node := to; node != from; node = via[node].From {
+					hops = append([]GraphEdge{via[node]}, hops...)
+				}
+				return hops, nil
+			}
+			queue = append(queue, edge.To)
+		}
+	}
+
+	return nil, fmt.Errorf("bundle %q cannot reach %q in channel %q", from, to, ch.Name)
+}
@@ -0,0 +1,147 @@
+package list
+
+import (
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestShortestUpgradePath(t *testing.T) {
+	ch := GraphChannel{
+		Name:  "stable",
+		Heads: []string{"pkg1.v1.2.0"},
+		Nodes: []string{"pkg1.v1.1.0", "pkg1.v1.1.1", "pkg1.v1.2.0", "pkg1.v2.0.0-alpha"},
+		Edges: []GraphEdge{
+			// pkg1.v1.0.0 was pruned from the channel but can still be upgraded.
+			{From: "pkg1.v1.0.0", To: "pkg1.v1.1.0", Type: EdgeReplaces},
+			{From: "pkg1.v1.1.0", To: "pkg1.v1.1.1", Type: EdgeReplaces},
+			{From: "pkg1.v1.1.1", To: "pkg1.v1.2.0", Type: EdgeReplaces},
+			{From: "pkg1.v1.1.0", To: "pkg1.v1.2.0", Type: EdgeSkipRange},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		from          string
+		to            string
+		expected      []GraphEdge
+		expectedError string
+	}{
+		{
+			name: "Success Case - Skip Range Shortcut",
+			from: "pkg1.v1.0.0",
+			to:   "pkg1.v1.2.0",
+			expected: []GraphEdge{
+				{From: "pkg1.v1.0.0", To: "pkg1.v1.1.0", Type: EdgeReplaces},
+				{From: "pkg1.v1.1.0", To: "pkg1.v1.2.0", Type: EdgeSkipRange},
+			},
+		},
+		{
+			name: "Success Case - Intermediate Target",
+			from: "pkg1.v1.1.0",
+			to:   "pkg1.v1.1.1",
+			expected: []GraphEdge{
+				{From: "pkg1.v1.1.0", To: "pkg1.v1.1.1", Type: EdgeReplaces},
+			},
+		},
+		{
+			name:     "Success Case - Already At Target",
+			from:     "pkg1.v1.2.0",
+			to:       "pkg1.v1.2.0",
+			expected: []GraphEdge{},
+		},
+		{
+			name:          "Failure Case - Unreachable Target",
+			from:          "pkg1.v1.2.0",
+			to:            "pkg1.v2.0.0-alpha",
+			expectedError: `bundle "pkg1.v1.2.0" cannot reach "pkg1.v2.0.0-alpha" in channel "stable"`,
+		},
+		{
+			name:          "Failure Case - Unknown Starting Bundle",
+			from:          "pkg1.v0.9.0",
+			to:            "pkg1.v1.2.0",
+			expectedError: `bundle "pkg1.v0.9.0" is not part of the upgrade graph of channel "stable"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hops, err := ShortestUpgradePath(ch, tc.from, tc.to)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, hops)
+		})
+	}
+}
+
+func TestUpgradePath(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+
+	testCases := []struct {
+		name          string
+		from          string
+		to            string
+		expected      *UpgradePath
+		expectedError string
+	}{
+		{
+			name: "Success Case - Defaults To Channel Head",
+			from: "pkg1.v1.0.0",
+			expected: &UpgradePath{
+				Package: "pkg1",
+				Channel: "stable",
+				From:    "pkg1.v1.0.0",
+				To:      "pkg1.v1.2.0",
+				Hops:    []GraphEdge{{From: "pkg1.v1.0.0", To: "pkg1.v1.2.0", Type: EdgeSkipRange}},
+			},
+		},
+		{
+			name: "Success Case - Explicit Target",
+			from: "pkg1.v1.0.0",
+			to:   "pkg1.v1.1.1",
+			expected: &UpgradePath{
+				Package: "pkg1",
+				Channel: "stable",
+				From:    "pkg1.v1.0.0",
+				To:      "pkg1.v1.1.1",
+				Hops:    []GraphEdge{{From: "pkg1.v1.0.0", To: "pkg1.v1.1.1", Type: EdgeSkips}},
+			},
+		},
+		{
+			name:          "Failure Case - Missing Starting Bundle",
+			expectedError: "catalog reference, package name, channel name, and starting bundle are required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, nil)
+
+			if tc.from != "" {
+				mockCataloger.EXPECT().CatalogConfig("test-catalog:latest").Return(graphTestConfig(), nil)
+			}
+
+			result, err := lister.UpgradePath("test-catalog:latest", "pkg1", "stable", tc.from, tc.to)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
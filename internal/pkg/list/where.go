@@ -0,0 +1,77 @@
+package list
+
+import (
+	"fmt"
+)
+
+// PackageLocations tells which catalogs of an OpenShift version contain a package.
+type PackageLocations struct {
+	Package    string            `json:"package"`
+	OCPVersion string            `json:"ocpVersion"`
+	Locations  []PackageLocation `json:"locations"`
+	// Duplicate is set when more than one catalog ships the package.
+	Duplicate bool `json:"duplicate"`
+}
+
+// PackageLocation is a catalog containing a package.
+type PackageLocation struct {
+	Catalog        string `json:"catalog"`
+	Source         string `json:"source"`
+	Label          string `json:"label,omitempty"`
+	DefaultChannel string `json:"defaultChannel"`
+	Head           string `json:"head,omitempty"`
+	HeadVersion    string `json:"headVersion,omitempty"`
+	Deprecation    string `json:"deprecation,omitempty"`
+}
+
+// Where looks for a package in every catalog the given sources publish for an OpenShift version,
+// loading the catalogs concurrently. Locations are returned in source order.
+func (c *CatalogLister) Where(pkgName, version string, sources []CatalogSource) (*PackageLocations, error) {
+	if pkgName == "" {
+		return nil, fmt.Errorf("a package name is required")
+	}
+	catalogs, err := c.Catalogs(version, sources)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Debugf("Looking for package %s in %d catalogs...", pkgName, len(catalogs))
+
+	refs := make([]string, len(catalogs))
+	byRef := make(map[string]Catalog, len(catalogs))
+	for i, catalog := range catalogs {
+		refs[i] = catalog.Reference
+		byRef[catalog.Reference] = catalog
+	}
+	loaded, err := c.loadCatalogs(refs)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := &PackageLocations{Package: pkgName, OCPVersion: version, Locations: []PackageLocation{}}
+	for _, l := range loaded {
+		for _, pkg := range l.cfg.Packages {
+			if pkg.Name != pkgName {
+				continue
+			}
+			catalog := byRef[l.ref]
+			head := defaultChannelHead(l.cfg, pkg, bundlesByName(l.cfg, pkgName))
+			location := PackageLocation{
+				Catalog:        catalog.Reference,
+				Source:         catalog.Source,
+				Label:          catalog.Label,
+				DefaultChannel: pkg.DefaultChannel,
+				HeadVersion:    bundleVersion(head),
+				Deprecation:    newDeprecationIndex(l.cfg).message(pkgName, DeprecationPackage, pkgName),
+			}
+			if head != nil {
+				location.Head = head.Name
+			}
+			locations.Locations = append(locations.Locations, location)
+			break
+		}
+	}
+	locations.Duplicate = len(locations.Locations) > 1
+
+	c.log.Debugf("Found package %s in %d catalogs.", pkgName, len(locations.Locations))
+	return locations, nil
+}
@@ -0,0 +1,123 @@
+package list
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list/mock"
+	"github.com/aguidirh/lumen/internal/pkg/log"
+	"github.com/opencontainers/go-digest"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+// whereTestConfig returns a catalog shipping the given package with a single stable channel.
+func whereTestConfig(pkg, version string) *declcfg.DeclarativeConfig {
+	name := pkg + ".v" + version
+	return &declcfg.DeclarativeConfig{
+		Packages: []declcfg.Package{{Name: pkg, DefaultChannel: "stable"}},
+		Channels: []declcfg.Channel{{Name: "stable", Package: pkg, Entries: []declcfg.ChannelEntry{{Name: name}}}},
+		Bundles:  []declcfg.Bundle{{Name: name, Package: pkg, Properties: []property.Property{property.MustBuildPackage(pkg, version)}}},
+	}
+}
+
+func TestWhere(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	logger := log.New("error")
+	sources := []CatalogSource{
+		{Name: "redhat", Label: "Red Hat Operators", Repository: "registry.example.com/redhat-index"},
+		{Name: "community", Repository: "registry.example.com/community-index"},
+		{Name: "certified", Repository: "registry.example.com/certified-index"},
+	}
+	found := func(m *mock.MockImager) {
+		m.EXPECT().RemoteInfo("registry.example.com/redhat-index:v4.16").Return("name", "tag", digest.FromString("a"), nil)
+		m.EXPECT().RemoteInfo("registry.example.com/community-index:v4.16").Return("name", "tag", digest.FromString("b"), nil)
+		m.EXPECT().RemoteInfo("registry.example.com/certified-index:v4.16").Return("", "", digest.Digest(""), errors.New("not found"))
+	}
+
+	testCases := []struct {
+		name          string
+		pkgName       string
+		setupMocks    func(i *mock.MockImager, c *mock.MockCataloger)
+		expected      *PackageLocations
+		expectErr     bool
+		expectedError string
+	}{
+		{
+			name:    "Success Case - Duplicate Across Catalogs",
+			pkgName: "grafana-operator",
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				found(i)
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.16").Return(whereTestConfig("grafana-operator", "5.0.0"), nil)
+				c.EXPECT().CatalogConfig("registry.example.com/community-index:v4.16").Return(whereTestConfig("grafana-operator", "5.1.0"), nil)
+			},
+			expected: &PackageLocations{
+				Package:    "grafana-operator",
+				OCPVersion: "4.16",
+				Locations: []PackageLocation{
+					{Catalog: "registry.example.com/redhat-index:v4.16", Source: "redhat", Label: "Red Hat Operators", DefaultChannel: "stable", Head: "grafana-operator.v5.0.0", HeadVersion: "5.0.0"},
+					{Catalog: "registry.example.com/community-index:v4.16", Source: "community", DefaultChannel: "stable", Head: "grafana-operator.v5.1.0", HeadVersion: "5.1.0"},
+				},
+				Duplicate: true,
+			},
+		},
+		{
+			name:    "Success Case - Single Catalog",
+			pkgName: "grafana-operator",
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				found(i)
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.16").Return(whereTestConfig("other-operator", "1.0.0"), nil)
+				c.EXPECT().CatalogConfig("registry.example.com/community-index:v4.16").Return(whereTestConfig("grafana-operator", "5.1.0"), nil)
+			},
+			expected: &PackageLocations{
+				Package:    "grafana-operator",
+				OCPVersion: "4.16",
+				Locations: []PackageLocation{
+					{Catalog: "registry.example.com/community-index:v4.16", Source: "community", DefaultChannel: "stable", Head: "grafana-operator.v5.1.0", HeadVersion: "5.1.0"},
+				},
+			},
+		},
+		{
+			name:    "Success Case - Not Found",
+			pkgName: "grafana-operator",
+			setupMocks: func(i *mock.MockImager, c *mock.MockCataloger) {
+				found(i)
+				c.EXPECT().CatalogConfig("registry.example.com/redhat-index:v4.16").Return(whereTestConfig("other-operator", "1.0.0"), nil)
+				c.EXPECT().CatalogConfig("registry.example.com/community-index:v4.16").Return(nil, errors.New("some catalog error"))
+			},
+			expected: &PackageLocations{Package: "grafana-operator", OCPVersion: "4.16", Locations: []PackageLocation{}},
+		},
+		{
+			name:          "Failure Case - No Package",
+			setupMocks:    func(i *mock.MockImager, c *mock.MockCataloger) {},
+			expectErr:     true,
+			expectedError: "a package name is required",
+		},
+	}
+
+	// 2. Execution and Assertion
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockImager := mock.NewMockImager(mockCtrl)
+			mockCataloger := mock.NewMockCataloger(mockCtrl)
+			lister := NewCatalogLister(logger, mockCataloger, mockImager)
+
+			tc.setupMocks(mockImager, mockCataloger)
+
+			result, err := lister.Where(tc.pkgName, "4.16", sources)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, result)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+)
+
+// PrintBuiltImage prints where a catalog image was written and pushed to.
+func (p *Printer) PrintBuiltImage(built *catalog.BuiltImage) {
+	p.log.Debugf("Printing built image %s", built.Output)
+	fmt.Fprintf(p.out, "Built catalog image with %d packages on %s.\n", built.Packages, built.Base)
+	fmt.Fprintf(p.out, "Wrote %s (%s)\n", built.Output, built.Digest)
+	if built.Push != "" {
+		fmt.Fprintf(p.out, "Pushed %s\n", built.Push)
+	}
+}
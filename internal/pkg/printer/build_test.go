@@ -0,0 +1,49 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintBuiltImage(t *testing.T) {
+	testCases := []struct {
+		name     string
+		built    *catalog.BuiltImage
+		expected string
+	}{
+		{
+			name:  "Written",
+			built: &catalog.BuiltImage{Base: "opm:latest", Output: "oci:./out:v1", Digest: "sha256:abc", Packages: 2},
+			expected: "Built catalog image with 2 packages on opm:latest.\n" +
+				"Wrote oci:./out:v1 (sha256:abc)\n",
+		},
+		{
+			name:  "Pushed",
+			built: &catalog.BuiltImage{Base: "opm:latest", Output: "oci:./out:v1", Push: "registry.example.com/catalog:v1", Digest: "sha256:abc", Packages: 2},
+			expected: "Built catalog image with 2 packages on opm:latest.\n" +
+				"Wrote oci:./out:v1 (sha256:abc)\n" +
+				"Pushed registry.example.com/catalog:v1\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing built image %s", tc.built.Output).Times(1)
+
+			p.PrintBuiltImage(tc.built)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
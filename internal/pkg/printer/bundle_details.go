@@ -0,0 +1,73 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/operator-framework/operator-registry/alpha/property"
+)
+
+// PrintBundleDetails prints the metadata of a bundle as a table, JSON or YAML.
+func (p *Printer) PrintBundleDetails(details *list.BundleDetails, format string) error {
+	p.log.Debugf("Printing details of bundle %s in %s format", details.Name, format)
+	if format != OutputTable {
+		return p.printStructured(format, details)
+	}
+
+	fmt.Fprintf(p.w, "Name:\t%s\n", details.Name)
+	fmt.Fprintf(p.w, "Package:\t%s\n", details.Package)
+	fmt.Fprintf(p.w, "Version:\t%s\n", orNone(details.Version))
+	fmt.Fprintf(p.w, "Image:\t%s\n", details.Image)
+	fmt.Fprintf(p.w, "Channels:\t%s\n", orNone(strings.Join(details.Channels, ",")))
+	fmt.Fprintf(p.w, "Min Kube Version:\t%s\n", orNone(details.MinKubeVersion))
+	if csv := details.CSVMetadata; csv != nil {
+		fmt.Fprintf(p.w, "Display Name:\t%s\n", orNone(csv.DisplayName))
+		fmt.Fprintf(p.w, "Provider:\t%s\n", orNone(csv.Provider.Name))
+		fmt.Fprintf(p.w, "Maturity:\t%s\n", orNone(csv.Maturity))
+		fmt.Fprintf(p.w, "Capabilities:\t%s\n", orNone(csv.Annotations["capabilities"]))
+		fmt.Fprintf(p.w, "Keywords:\t%s\n", orNone(strings.Join(csv.Keywords, ",")))
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nProvided APIs:")
+	fmt.Fprintln(p.w, "  GROUP\tVERSION\tKIND")
+	for _, gvk := range details.ProvidedGVKs {
+		fmt.Fprintf(p.w, "  %s\t%s\t%s\n", gvk.Group, gvk.Version, gvk.Kind)
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nRequired APIs:")
+	fmt.Fprintln(p.w, "  GROUP\tVERSION\tKIND")
+	for _, gvk := range details.RequiredGVKs {
+		fmt.Fprintf(p.w, "  %s\t%s\t%s\n", gvk.Group, gvk.Version, gvk.Kind)
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nRequired Packages:")
+	fmt.Fprintln(p.w, "  PACKAGE\tVERSION RANGE")
+	for _, pkg := range details.RequiredPackages {
+		fmt.Fprintf(p.w, "  %s\t%s\n", pkg.PackageName, pkg.VersionRange)
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nRelated Images:")
+	fmt.Fprintln(p.w, "  NAME\tIMAGE")
+	for _, img := range details.RelatedImages {
+		fmt.Fprintf(p.w, "  %s\t%s\n", orNone(img.Name), img.Image)
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nProperties:")
+	fmt.Fprintln(p.w, "  TYPE\tVALUE")
+	for _, prop := range details.Properties {
+		// CSV metadata is already summarized above and is too large for a table cell.
+		if prop.Type == property.TypeCSVMetadata {
+			fmt.Fprintf(p.w, "  %s\t%s\n", prop.Type, "<use -o yaml to view>")
+			continue
+		}
+		fmt.Fprintf(p.w, "  %s\t%s\n", prop.Type, string(prop.Value))
+	}
+	p.w.Flush()
+	return nil
+}
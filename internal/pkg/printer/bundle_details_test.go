@@ -0,0 +1,105 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/operator-framework/operator-registry/alpha/declcfg"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testBundleDetails() *list.BundleDetails {
+	return &list.BundleDetails{
+		Name:           "pkg.v1.0.0",
+		Package:        "pkg",
+		Version:        "1.0.0",
+		Image:          "quay.io/pkg-bundle:v1.0.0",
+		Channels:       []string{"stable"},
+		MinKubeVersion: "1.25.0",
+		CSVMetadata:    &property.CSVMetadata{DisplayName: "Package"},
+		ProvidedGVKs:   []property.GVK{{Group: "example.com", Version: "v1", Kind: "Widget"}},
+		RelatedImages:  []declcfg.RelatedImage{{Name: "operator", Image: "quay.io/pkg:v1.0.0"}},
+		Properties:     []property.Property{property.MustBuildPackage("pkg", "1.0.0")},
+	}
+}
+
+func TestPrintBundleDetails(t *testing.T) {
+	testCases := []struct {
+		name      string
+		format    string
+		assertOut func(t *testing.T, out string)
+		expectErr bool
+	}{
+		{
+			name:   "Table Output",
+			format: OutputTable,
+			assertOut: func(t *testing.T, out string) {
+				assert.Contains(t, out, "Name:              pkg.v1.0.0")
+				assert.Contains(t, out, "Min Kube Version:  1.25.0")
+				assert.Contains(t, out, "Display Name:      Package")
+				assert.Contains(t, out, "example.com  v1       Widget")
+				assert.Contains(t, out, "operator  quay.io/pkg:v1.0.0")
+				assert.Contains(t, out, `olm.package  {"packageName":"pkg","version":"1.0.0"}`)
+			},
+		},
+		{
+			name:   "JSON Output",
+			format: OutputJSON,
+			assertOut: func(t *testing.T, out string) {
+				var details list.BundleDetails
+				require.NoError(t, json.Unmarshal([]byte(out), &details))
+				expected := testBundleDetails()
+				assert.Equal(t, expected.Name, details.Name)
+				assert.Equal(t, expected.Channels, details.Channels)
+				assert.Equal(t, expected.CSVMetadata, details.CSVMetadata)
+				assert.Equal(t, expected.ProvidedGVKs, details.ProvidedGVKs)
+				assert.Equal(t, expected.RelatedImages, details.RelatedImages)
+				require.Len(t, details.Properties, 1)
+				assert.JSONEq(t, string(expected.Properties[0].Value), string(details.Properties[0].Value))
+			},
+		},
+		{
+			name:   "YAML Output",
+			format: OutputYAML,
+			assertOut: func(t *testing.T, out string) {
+				assert.Contains(t, out, "name: pkg.v1.0.0\n")
+				assert.Contains(t, out, "minKubeVersion: 1.25.0\n")
+				assert.Contains(t, out, "- group: example.com\n")
+			},
+		},
+		{
+			name:      "Unsupported Output",
+			format:    "xml",
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing details of bundle %s in %s format", "pkg.v1.0.0", tc.format).Times(1)
+
+			err := p.PrintBundleDetails(testBundleDetails(), tc.format)
+
+			if tc.expectErr {
+				assert.EqualError(t, err, `unsupported output format "xml"`)
+				return
+			}
+			require.NoError(t, err)
+			tc.assertOut(t, strings.TrimSpace(buf.String()))
+		})
+	}
+}
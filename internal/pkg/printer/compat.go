@@ -0,0 +1,31 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintCompatibility prints the compatibility of channel heads with an OpenShift version as a table, JSON or YAML.
+func (p *Printer) PrintCompatibility(report *list.CompatibilityReport, format string) error {
+	p.log.Debugf("Printing compatibility of %d channel heads with OpenShift %s in %s format", len(report.Results), report.TargetOCP, format)
+	if format != OutputTable {
+		return p.printStructured(format, report)
+	}
+
+	fmt.Fprintf(p.out, "Compatibility with OpenShift %s (Kubernetes %s):\n\n", report.TargetOCP, report.TargetKube)
+	if len(report.Results) == 0 {
+		fmt.Fprintln(p.out, "No channel heads found.")
+		return nil
+	}
+	fmt.Fprintln(p.w, "PACKAGE\tCHANNEL\tHEAD\tMAX OCP\tMIN KUBE\tSTATUS\tREASON")
+	for _, r := range report.Results {
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Package, r.Channel, r.Head, orNone(r.MaxOpenShiftVersion), orNone(r.MinKubeVersion), r.Status, orNone(r.Reason))
+	}
+	p.w.Flush()
+
+	if incompatible := report.Incompatible(); incompatible > 0 {
+		fmt.Fprintf(p.out, "\n%d channel heads are not compatible with OpenShift %s.\n", incompatible, report.TargetOCP)
+	}
+	return nil
+}
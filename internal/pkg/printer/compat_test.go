@@ -0,0 +1,72 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintCompatibility(t *testing.T) {
+	report := &list.CompatibilityReport{
+		Catalog:    "catalog:v4.18",
+		TargetOCP:  "4.18",
+		TargetKube: "1.31",
+		Results: []list.ChannelCompatibility{
+			{Package: "legacy-operator", Channel: "stable", Head: "legacy-operator.v1.1.0", MaxOpenShiftVersion: "4.16", Status: list.CompatIncompatible, Reason: "maxOpenShiftVersion 4.16 is older than 4.18"},
+			{Package: "modern-operator", Channel: "stable", Head: "modern-operator.v1.0.0", MinKubeVersion: "1.25.0", Status: list.CompatCompatible},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		report   *list.CompatibilityReport
+		format   string
+		expected string
+	}{
+		{
+			name:   "Table Output",
+			report: report,
+			format: OutputTable,
+			expected: "Compatibility with OpenShift 4.18 (Kubernetes 1.31):\n\n" +
+				"PACKAGE          CHANNEL  HEAD                    MAX OCP  MIN KUBE  STATUS        REASON\n" +
+				"legacy-operator  stable   legacy-operator.v1.1.0  4.16     -         incompatible  maxOpenShiftVersion 4.16 is older than 4.18\n" +
+				"modern-operator  stable   modern-operator.v1.0.0  -        1.25.0    compatible    -\n" +
+				"\n1 channel heads are not compatible with OpenShift 4.18.\n",
+		},
+		{
+			name:     "Table Output - No Channel Heads",
+			report:   &list.CompatibilityReport{TargetOCP: "4.18", TargetKube: "1.31"},
+			format:   OutputTable,
+			expected: "Compatibility with OpenShift 4.18 (Kubernetes 1.31):\n\nNo channel heads found.\n",
+		},
+		{
+			name:   "YAML Output",
+			report: &list.CompatibilityReport{Catalog: "catalog:v4.18", TargetOCP: "4.18", TargetKube: "1.31", Results: report.Results[1:]},
+			format: OutputYAML,
+			expected: "catalog: catalog:v4.18\nresults:\n- channel: stable\n  head: modern-operator.v1.0.0\n  minKubeVersion: 1.25.0\n" +
+				"  package: modern-operator\n  status: compatible\ntargetKube: \"1.31\"\ntargetOCP: \"4.18\"\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing compatibility of %d channel heads with OpenShift %s in %s format", len(tc.report.Results), tc.report.TargetOCP, tc.format).Times(1)
+
+			err := p.PrintCompatibility(tc.report, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintDeprecations prints the deprecated packages, channels and bundles of a catalog as a table, JSON or YAML.
+func (p *Printer) PrintDeprecations(deprecations []list.Deprecation, format string) error {
+	p.log.Debugf("Printing %d deprecations in %s format", len(deprecations), format)
+	if format != OutputTable {
+		if deprecations == nil {
+			deprecations = []list.Deprecation{}
+		}
+		return p.printStructured(format, deprecations)
+	}
+
+	if len(deprecations) == 0 {
+		fmt.Fprintln(p.out, "No deprecations found.")
+		return nil
+	}
+	fmt.Fprintln(p.w, "PACKAGE\tKIND\tNAME\tMESSAGE")
+	for _, d := range deprecations {
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\n", d.Package, d.Kind, d.Name, d.Message)
+	}
+	p.w.Flush()
+	return nil
+}
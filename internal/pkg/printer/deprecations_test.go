@@ -0,0 +1,64 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintDeprecations(t *testing.T) {
+	deprecations := []list.Deprecation{
+		{Package: "pkg1", Kind: list.DeprecationPackage, Name: "pkg1", Message: "use pkg2"},
+		{Package: "pkg2", Kind: list.DeprecationBundle, Name: "pkg2.v1.0.0", Message: "data loss bug"},
+	}
+
+	testCases := []struct {
+		name         string
+		deprecations []list.Deprecation
+		format       string
+		expected     string
+	}{
+		{
+			name:         "Table Output",
+			deprecations: deprecations,
+			format:       OutputTable,
+			expected: "PACKAGE  KIND     NAME         MESSAGE\n" +
+				"pkg1     package  pkg1         use pkg2\n" +
+				"pkg2     bundle   pkg2.v1.0.0  data loss bug\n",
+		},
+		{
+			name:     "Table Output - No Deprecations",
+			format:   OutputTable,
+			expected: "No deprecations found.\n",
+		},
+		{
+			name:         "JSON Output",
+			deprecations: deprecations[:1],
+			format:       OutputJSON,
+			expected:     "[\n  {\n    \"package\": \"pkg1\",\n    \"kind\": \"package\",\n    \"name\": \"pkg1\",\n    \"message\": \"use pkg2\"\n  }\n]\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d deprecations in %s format", len(tc.deprecations), tc.format).Times(1)
+
+			err := p.PrintDeprecations(tc.deprecations, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintDependencies prints the dependency tree of a bundle as indented text, JSON or YAML.
+func (p *Printer) PrintDependencies(tree *list.DependencyTree, format string) error {
+	p.log.Debugf("Printing dependency tree of bundle %s in %s format", tree.Root.Bundle, format)
+	if format != OutputText {
+		return p.printStructured(format, tree)
+	}
+
+	fmt.Fprintln(p.out, dependencyLabel(tree.Root))
+	p.printDependencyNodes(tree.Root.Dependencies, "")
+	if tree.Unsatisfied == 0 {
+		fmt.Fprintln(p.out, "\nAll requirements are satisfied.")
+	} else {
+		fmt.Fprintf(p.out, "\n%d unsatisfied requirements.\n", tree.Unsatisfied)
+	}
+	return nil
+}
+
+// printDependencyNodes prints the nodes of a subtree with box-drawing branches.
+func (p *Printer) printDependencyNodes(nodes []list.DependencyNode, indent string) {
+	for i, node := range nodes {
+		branch, next := "├── ", "│   "
+		if i == len(nodes)-1 {
+			branch, next = "└── ", "    "
+		}
+		fmt.Fprintf(p.out, "%s%s%s: %s\n", indent, branch, node.Requirement, dependencyLabel(node))
+		p.printDependencyNodes(node.Dependencies, indent+next)
+	}
+}
+
+// dependencyLabel describes the bundle chosen for a node, or why there is none.
+func dependencyLabel(node list.DependencyNode) string {
+	if node.Unsatisfied != "" {
+		return "UNSATISFIED (" + node.Unsatisfied + ")"
+	}
+	label := fmt.Sprintf("%s (%s %s)", node.Bundle, node.Package, orNone(node.Version))
+	if node.Cycle {
+		label += " [cycle]"
+	}
+	return label
+}
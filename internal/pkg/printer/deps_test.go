@@ -0,0 +1,84 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintDependencies(t *testing.T) {
+	tree := &list.DependencyTree{
+		Catalog: "catalog:v4.18",
+		Root: list.DependencyNode{
+			Package: "app", Bundle: "app.v1.0.0", Version: "1.0.0",
+			Dependencies: []list.DependencyNode{
+				{
+					Requirement: &list.Requirement{Type: list.RequirementPackage, Package: "database", VersionRange: "<2.0.0"},
+					Package:     "database", Bundle: "database.v1.5.0", Version: "1.5.0",
+					Dependencies: []list.DependencyNode{
+						{Requirement: &list.Requirement{Type: list.RequirementPackage, Package: "app"}, Package: "app", Bundle: "app.v1.0.0", Version: "1.0.0", Cycle: true},
+					},
+				},
+				{
+					Requirement: &list.Requirement{Type: list.RequirementAPI, API: &property.GVK{Group: "missing.example.com", Version: "v1", Kind: "Missing"}},
+					Unsatisfied: "no default channel head provides the API",
+				},
+			},
+		},
+		Unsatisfied: 1,
+	}
+
+	testCases := []struct {
+		name     string
+		tree     *list.DependencyTree
+		format   string
+		expected string
+	}{
+		{
+			name:   "Text Output",
+			tree:   tree,
+			format: OutputText,
+			expected: "app.v1.0.0 (app 1.0.0)\n" +
+				"├── package database <2.0.0: database.v1.5.0 (database 1.5.0)\n" +
+				"│   └── package app: app.v1.0.0 (app 1.0.0) [cycle]\n" +
+				"└── api Missing.missing.example.com/v1: UNSATISFIED (no default channel head provides the API)\n" +
+				"\n1 unsatisfied requirements.\n",
+		},
+		{
+			name:     "Text Output - Satisfied",
+			tree:     &list.DependencyTree{Catalog: "catalog:v4.18", Root: list.DependencyNode{Package: "cache", Bundle: "cache.v3.0.0", Version: "3.0.0"}},
+			format:   OutputText,
+			expected: "cache.v3.0.0 (cache 3.0.0)\n\nAll requirements are satisfied.\n",
+		},
+		{
+			name:   "JSON Output",
+			tree:   &list.DependencyTree{Catalog: "catalog:v4.18", Root: list.DependencyNode{Package: "cache", Bundle: "cache.v3.0.0", Version: "3.0.0"}},
+			format: OutputJSON,
+			expected: "{\n  \"catalog\": \"catalog:v4.18\",\n  \"root\": {\n    \"package\": \"cache\",\n" +
+				"    \"bundle\": \"cache.v3.0.0\",\n    \"version\": \"3.0.0\"\n  },\n  \"unsatisfied\": 0\n}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing dependency tree of bundle %s in %s format", tc.tree.Root.Bundle, tc.format).Times(1)
+
+			err := p.PrintDependencies(tc.tree, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// Output formats accepted by PrintDiff in addition to json and yaml.
+const (
+	OutputText     = "text"
+	OutputMarkdown = "markdown"
+)
+
+// PrintDiff prints the differences between two catalogs as text, Markdown, JSON or YAML.
+func (p *Printer) PrintDiff(diff *list.CatalogDiff, format string) error {
+	p.log.Debugf("Printing diff between %s and %s in %s format", diff.From, diff.To, format)
+	switch format {
+	case OutputText:
+		p.printTextDiff(diff)
+	case OutputMarkdown:
+		p.printMarkdownDiff(diff)
+	default:
+		return p.printStructured(format, diff)
+	}
+	return nil
+}
+
+func (p *Printer) printTextDiff(diff *list.CatalogDiff) {
+	fmt.Fprintf(p.out, "Comparing %s to %s\n", diff.From, diff.To)
+	if diff.IsEmpty() {
+		fmt.Fprintln(p.out, "\nNo differences found.")
+		return
+	}
+	if len(diff.AddedPackages) > 0 {
+		fmt.Fprintln(p.out, "\nAdded packages:")
+		for _, pkg := range diff.AddedPackages {
+			fmt.Fprintf(p.out, "  + %s\n", pkg)
+		}
+	}
+	if len(diff.RemovedPackages) > 0 {
+		fmt.Fprintln(p.out, "\nRemoved packages:")
+		for _, pkg := range diff.RemovedPackages {
+			fmt.Fprintf(p.out, "  - %s\n", pkg)
+		}
+	}
+	if len(diff.ChangedPackages) > 0 {
+		fmt.Fprintln(p.out, "\nChanged packages:")
+		for _, pkg := range diff.ChangedPackages {
+			fmt.Fprintf(p.out, "  %s:\n", pkg.Name)
+			if pkg.OldDefaultChannel != pkg.NewDefaultChannel {
+				fmt.Fprintf(p.out, "    default channel: %s -> %s\n", pkg.OldDefaultChannel, pkg.NewDefaultChannel)
+			}
+			for _, ch := range pkg.AddedChannels {
+				fmt.Fprintf(p.out, "    + channel %s\n", ch)
+			}
+			for _, ch := range pkg.RemovedChannels {
+				fmt.Fprintf(p.out, "    - channel %s\n", ch)
+			}
+			for _, b := range pkg.AddedBundles {
+				fmt.Fprintf(p.out, "    + bundle %s\n", b)
+			}
+			for _, b := range pkg.RemovedBundles {
+				fmt.Fprintf(p.out, "    - bundle %s\n", b)
+			}
+			for _, head := range pkg.ChangedHeads {
+				fmt.Fprintf(p.out, "    head of channel %s: %s -> %s\n", head.Channel, head.From, head.To)
+			}
+		}
+	}
+}
+
+func (p *Printer) printMarkdownDiff(diff *list.CatalogDiff) {
+	fmt.Fprintln(p.out, "# Catalog diff")
+	fmt.Fprintf(p.out, "\n- From: `%s`\n- To: `%s`\n", diff.From, diff.To)
+	if diff.IsEmpty() {
+		fmt.Fprintln(p.out, "\nNo differences found.")
+		return
+	}
+	if len(diff.AddedPackages) > 0 {
+		fmt.Fprint(p.out, "\n## Added packages\n\n")
+		for _, pkg := range diff.AddedPackages {
+			fmt.Fprintf(p.out, "- `%s`\n", pkg)
+		}
+	}
+	if len(diff.RemovedPackages) > 0 {
+		fmt.Fprint(p.out, "\n## Removed packages\n\n")
+		for _, pkg := range diff.RemovedPackages {
+			fmt.Fprintf(p.out, "- `%s`\n", pkg)
+		}
+	}
+	if len(diff.ChangedPackages) > 0 {
+		fmt.Fprintln(p.out, "\n## Changed packages")
+		for _, pkg := range diff.ChangedPackages {
+			fmt.Fprintf(p.out, "\n### %s\n\n", pkg.Name)
+			if pkg.OldDefaultChannel != pkg.NewDefaultChannel {
+				fmt.Fprintf(p.out, "- Default channel: `%s` -> `%s`\n", pkg.OldDefaultChannel, pkg.NewDefaultChannel)
+			}
+			printMarkdownList(p, "Added channels", pkg.AddedChannels)
+			printMarkdownList(p, "Removed channels", pkg.RemovedChannels)
+			printMarkdownList(p, "Added bundles", pkg.AddedBundles)
+			printMarkdownList(p, "Removed bundles", pkg.RemovedBundles)
+			for _, head := range pkg.ChangedHeads {
+				fmt.Fprintf(p.out, "- Head of channel `%s`: `%s` -> `%s`\n", head.Channel, head.From, head.To)
+			}
+		}
+	}
+}
+
+// printMarkdownList prints a Markdown list item with the code formatted values, if any.
+func printMarkdownList(p *Printer, label string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	quoted := make([]string, len(values))
+	for i, v := range values {
+		quoted[i] = "`" + v + "`"
+	}
+	fmt.Fprintf(p.out, "- %s: %s\n", label, strings.Join(quoted, ", "))
+}
@@ -0,0 +1,103 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintDiff(t *testing.T) {
+	diff := &list.CatalogDiff{
+		From:            "catalog:v1",
+		To:              "catalog:v2",
+		AddedPackages:   []string{"pkg3"},
+		RemovedPackages: []string{"pkg2"},
+		ChangedPackages: []list.PackageDiff{{
+			Name:              "pkg1",
+			OldDefaultChannel: "stable",
+			NewDefaultChannel: "fast",
+			AddedChannels:     []string{"beta"},
+			AddedBundles:      []string{"pkg1.v1.3.0", "pkg1.v1.4.0"},
+			RemovedBundles:    []string{"pkg1.v1.0.0"},
+			ChangedHeads:      []list.HeadChange{{Channel: "fast", From: "pkg1.v1.2.0", To: "pkg1.v1.4.0"}},
+		}},
+	}
+
+	testCases := []struct {
+		name     string
+		diff     *list.CatalogDiff
+		format   string
+		expected string
+	}{
+		{
+			name:   "Text Output",
+			diff:   diff,
+			format: OutputText,
+			expected: `Comparing catalog:v1 to catalog:v2
+
+Added packages:
+  + pkg3
+
+Removed packages:
+  - pkg2
+
+Changed packages:
+  pkg1:
+    default channel: stable -> fast
+    + channel beta
+    + bundle pkg1.v1.3.0
+    + bundle pkg1.v1.4.0
+    - bundle pkg1.v1.0.0
+    head of channel fast: pkg1.v1.2.0 -> pkg1.v1.4.0`,
+		},
+		{
+			name:     "Text Output - No Differences",
+			diff:     &list.CatalogDiff{From: "catalog:v1", To: "catalog:v1"},
+			format:   OutputText,
+			expected: "Comparing catalog:v1 to catalog:v1\n\nNo differences found.",
+		},
+		{
+			name:   "Markdown Output",
+			diff:   diff,
+			format: OutputMarkdown,
+			expected: "# Catalog diff\n\n- From: `catalog:v1`\n- To: `catalog:v2`\n\n" +
+				"## Added packages\n\n- `pkg3`\n\n" +
+				"## Removed packages\n\n- `pkg2`\n\n" +
+				"## Changed packages\n\n### pkg1\n\n" +
+				"- Default channel: `stable` -> `fast`\n" +
+				"- Added channels: `beta`\n" +
+				"- Added bundles: `pkg1.v1.3.0`, `pkg1.v1.4.0`\n" +
+				"- Removed bundles: `pkg1.v1.0.0`\n" +
+				"- Head of channel `fast`: `pkg1.v1.2.0` -> `pkg1.v1.4.0`",
+		},
+		{
+			name:     "YAML Output",
+			diff:     &list.CatalogDiff{From: "catalog:v1", To: "catalog:v2", AddedPackages: []string{"pkg3"}},
+			format:   OutputYAML,
+			expected: "addedPackages:\n- pkg3\nfrom: catalog:v1\nto: catalog:v2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing diff between %s and %s in %s format", tc.diff.From, tc.diff.To, tc.format).Times(1)
+
+			err := p.PrintDiff(tc.diff, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
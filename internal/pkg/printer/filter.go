@@ -0,0 +1,24 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintFilteredCatalog prints the packages kept in a filtered catalog and where it was written.
+func (p *Printer) PrintFilteredCatalog(filtered *list.FilteredCatalog) {
+	p.log.Debugf("Printing %d filtered packages", len(filtered.Packages))
+	fmt.Fprintln(p.w, "PACKAGE\tDEFAULT CHANNEL\tCHANNELS\tBUNDLES")
+	for _, pkg := range filtered.Packages {
+		defaultChannel := pkg.DefaultChannel
+		if pkg.PreviousDefaultChannel != "" {
+			defaultChannel += fmt.Sprintf(" (was %s)", pkg.PreviousDefaultChannel)
+		}
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%d\n", pkg.Name, defaultChannel, strings.Join(pkg.Channels, ","), pkg.Bundles)
+	}
+	p.w.Flush()
+
+	fmt.Fprintf(p.out, "\nWrote %d packages of %s to %s.\n", len(filtered.Packages), filtered.Catalog, filtered.OutputDir)
+}
@@ -0,0 +1,38 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintFilteredCatalog(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	filtered := &list.FilteredCatalog{
+		Catalog:   "catalog:v4.18",
+		OutputDir: "./out",
+		Packages: []list.FilteredPackage{
+			{Name: "logging", DefaultChannel: "stable-5.8", PreviousDefaultChannel: "stable-6.0", Channels: []string{"stable-5.8"}, Bundles: 2},
+			{Name: "jaeger", DefaultChannel: "stable", Channels: []string{"stable", "stable-1.x"}, Bundles: 7},
+		},
+	}
+	mockLogger.EXPECT().Debugf("Printing %d filtered packages", len(filtered.Packages)).Times(1)
+
+	p.PrintFilteredCatalog(filtered)
+
+	expected := "PACKAGE  DEFAULT CHANNEL              CHANNELS           BUNDLES\n" +
+		"logging  stable-5.8 (was stable-6.0)  stable-5.8         2\n" +
+		"jaeger   stable                       stable,stable-1.x  7\n" +
+		"\nWrote 2 packages of catalog:v4.18 to ./out.\n"
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,38 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintAPIMatches prints the bundles providing or requiring an API as a table, JSON or YAML.
+func (p *Printer) PrintAPIMatches(matches []list.APIMatch, format string) error {
+	p.log.Debugf("Printing %d API matches in %s format", len(matches), format)
+	if format != OutputTable {
+		if matches == nil {
+			matches = []list.APIMatch{}
+		}
+		return p.printStructured(format, matches)
+	}
+
+	if len(matches) == 0 {
+		fmt.Fprintln(p.out, "No matching bundles found.")
+		return nil
+	}
+	fmt.Fprintln(p.w, "PACKAGE\tBUNDLE\tVERSION\tCHANNELS\tRELATION\tAPI\tCATALOG")
+	for _, m := range matches {
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			m.Package,
+			m.Bundle,
+			orNone(m.BundleVersion),
+			orNone(strings.Join(m.Channels, ",")),
+			m.Relation,
+			list.FormatGVK(m.API),
+			m.Catalog,
+		)
+	}
+	p.w.Flush()
+	return nil
+}
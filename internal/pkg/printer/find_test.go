@@ -0,0 +1,71 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/operator-framework/operator-registry/alpha/property"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintAPIMatches(t *testing.T) {
+	matches := []list.APIMatch{
+		{Catalog: "catalog:v4.18", Package: "strimzi-kafka-operator", Bundle: "strimzi-cluster-operator.v0.41.0", BundleVersion: "0.41.0", Channels: []string{"stable", "candidate"}, Relation: list.RelationProvides, API: property.GVK{Group: "kafka.strimzi.io", Version: "v1beta2", Kind: "KafkaTopic"}},
+		{Catalog: "catalog:v4.18", Package: "config-operator", Bundle: "config-operator.v1.0.0", Relation: list.RelationRequires, API: property.GVK{Version: "v1", Kind: "ConfigMap"}},
+	}
+
+	testCases := []struct {
+		name     string
+		matches  []list.APIMatch
+		format   string
+		expected string
+	}{
+		{
+			name:    "Table Output",
+			matches: matches,
+			format:  OutputTable,
+			expected: "PACKAGE                 BUNDLE                            VERSION  CHANNELS          RELATION  API                                  CATALOG\n" +
+				"strimzi-kafka-operator  strimzi-cluster-operator.v0.41.0  0.41.0   stable,candidate  provides  KafkaTopic.kafka.strimzi.io/v1beta2  catalog:v4.18\n" +
+				"config-operator         config-operator.v1.0.0            -        -                 requires  ConfigMap/v1                         catalog:v4.18\n",
+		},
+		{
+			name:     "Table Output - No Matches",
+			format:   OutputTable,
+			expected: "No matching bundles found.\n",
+		},
+		{
+			name:     "JSON Output - No Matches",
+			format:   OutputJSON,
+			expected: "[]\n",
+		},
+		{
+			name:    "YAML Output",
+			matches: matches[1:],
+			format:  OutputYAML,
+			expected: "- api:\n    group: \"\"\n    kind: ConfigMap\n    version: v1\n  bundle: config-operator.v1.0.0\n" +
+				"  catalog: catalog:v4.18\n  package: config-operator\n  relation: requires\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d API matches in %s format", len(tc.matches), tc.format).Times(1)
+
+			err := p.PrintAPIMatches(tc.matches, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+)
+
+// Output formats accepted by the printers that support structured output.
+const (
+	OutputTable = "table"
+	OutputJSON  = "json"
+	OutputYAML  = "yaml"
+)
+
+// printStructured writes v to the output as indented JSON or YAML.
+func (p *Printer) printStructured(format string, v any) error {
+	switch format {
+	case OutputJSON:
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize output to json: %w", err)
+		}
+		fmt.Fprintln(p.out, string(data))
+	case OutputYAML:
+		data, err := yaml.Marshal(v)
+		if err != nil {
+			return fmt.Errorf("failed to serialize output to yaml: %w", err)
+		}
+		fmt.Fprint(p.out, string(data))
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+	return nil
+}
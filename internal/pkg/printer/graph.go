@@ -0,0 +1,152 @@
+package printer
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// Output formats accepted by PrintGraph.
+const (
+	OutputDOT     = "dot"
+	OutputMermaid = "mermaid"
+)
+
+// PrintGraph renders the upgrade graph of a package as Graphviz DOT, Mermaid or a JSON adjacency list.
+// Channel heads and the default channel are highlighted.
+func (p *Printer) PrintGraph(graph *list.UpgradeGraph, format string) error {
+	p.log.Debugf("Printing upgrade graph of package %s with %d channels in %s format", graph.Package, len(graph.Channels), format)
+	switch format {
+	case OutputDOT:
+		p.printDOTGraph(graph)
+	case OutputMermaid:
+		p.printMermaidGraph(graph)
+	case OutputJSON:
+		return p.printJSONGraph(graph)
+	default:
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+	return nil
+}
+
+func (p *Printer) printDOTGraph(graph *list.UpgradeGraph) {
+	fmt.Fprintf(p.out, "digraph %q {\n", graph.Package)
+	fmt.Fprintln(p.out, "  rankdir=LR;")
+	fmt.Fprintln(p.out, "  node [shape=box];")
+	for _, ch := range graph.Channels {
+		// Bundles can belong to several channels, so node IDs are scoped by channel.
+		nodeID := func(name string) string { return fmt.Sprintf("%q", ch.Name+"/"+name) }
+
+		fmt.Fprintf(p.out, "  subgraph %q {\n", "cluster_"+ch.Name)
+		if ch.Default {
+			fmt.Fprintf(p.out, "    label=%q;\n", ch.Name+" (default)")
+			fmt.Fprintln(p.out, "    style=bold;")
+		} else {
+			fmt.Fprintf(p.out, "    label=%q;\n", ch.Name)
+		}
+		for _, node := range ch.Nodes {
+			if slices.Contains(ch.Heads, node) {
+				fmt.Fprintf(p.out, "    %s [label=%q, style=filled, fillcolor=lightgreen];\n", nodeID(node), node)
+			} else {
+				fmt.Fprintf(p.out, "    %s [label=%q];\n", nodeID(node), node)
+			}
+		}
+		for _, edge := range ch.Edges {
+			switch edge.Type {
+			case list.EdgeSkips:
+				fmt.Fprintf(p.out, "    %s -> %s [style=dashed, label=%q];\n", nodeID(edge.From), nodeID(edge.To), edge.Type)
+			case list.EdgeSkipRange:
+				fmt.Fprintf(p.out, "    %s -> %s [style=dotted, label=%q];\n", nodeID(edge.From), nodeID(edge.To), edge.Type)
+			default:
+				fmt.Fprintf(p.out, "    %s -> %s;\n", nodeID(edge.From), nodeID(edge.To))
+			}
+		}
+		fmt.Fprintln(p.out, "  }")
+	}
+	fmt.Fprintln(p.out, "}")
+}
+
+func (p *Printer) printMermaidGraph(graph *list.UpgradeGraph) {
+	fmt.Fprintln(p.out, "graph LR")
+	fmt.Fprintln(p.out, "  classDef head fill:#90ee90,stroke:#333;")
+	// Mermaid IDs cannot hold every character allowed in bundle names, so nodes get generated IDs.
+	// A node is labeled when its ID is created, which also covers edges to bundles that are not
+	// in the channel, such as pruned or skipped bundles.
+	ids := make(map[string]string)
+	nodeID := func(channel, name string) string {
+		key := channel + "/" + name
+		id, ok := ids[key]
+		if !ok {
+			id = fmt.Sprintf("n%d", len(ids))
+			ids[key] = id
+			fmt.Fprintf(p.out, "    %s[%q]\n", id, name)
+		}
+		return id
+	}
+
+	for i, ch := range graph.Channels {
+		label := ch.Name
+		if ch.Default {
+			label += " (default)"
+		}
+		fmt.Fprintf(p.out, "  subgraph ch%d[%q]\n", i, label)
+		for _, node := range ch.Nodes {
+			nodeID(ch.Name, node)
+		}
+		for _, edge := range ch.Edges {
+			from, to := nodeID(ch.Name, edge.From), nodeID(ch.Name, edge.To)
+			switch edge.Type {
+			case list.EdgeSkips, list.EdgeSkipRange:
+				fmt.Fprintf(p.out, "    %s -. %s .-> %s\n", from, edge.Type, to)
+			default:
+				fmt.Fprintf(p.out, "    %s --> %s\n", from, to)
+			}
+		}
+		fmt.Fprintln(p.out, "  end")
+		for _, head := range ch.Heads {
+			fmt.Fprintf(p.out, "  class %s head\n", nodeID(ch.Name, head))
+		}
+	}
+}
+
+// jsonGraphChannel is the JSON view of a channel, with its edges grouped by source bundle.
+type jsonGraphChannel struct {
+	Name      string                     `json:"name"`
+	Default   bool                       `json:"default"`
+	Heads     []string                   `json:"heads"`
+	Nodes     []string                   `json:"nodes"`
+	Adjacency map[string][]jsonGraphEdge `json:"adjacency"`
+}
+
+type jsonGraphEdge struct {
+	To   string `json:"to"`
+	Type string `json:"type"`
+}
+
+func (p *Printer) printJSONGraph(graph *list.UpgradeGraph) error {
+	out := struct {
+		Package        string             `json:"package"`
+		DefaultChannel string             `json:"defaultChannel"`
+		Channels       []jsonGraphChannel `json:"channels"`
+	}{
+		Package:        graph.Package,
+		DefaultChannel: graph.DefaultChannel,
+		Channels:       []jsonGraphChannel{},
+	}
+	for _, ch := range graph.Channels {
+		jsonChannel := jsonGraphChannel{
+			Name:      ch.Name,
+			Default:   ch.Default,
+			Heads:     ch.Heads,
+			Nodes:     ch.Nodes,
+			Adjacency: make(map[string][]jsonGraphEdge),
+		}
+		for _, edge := range ch.Edges {
+			jsonChannel.Adjacency[edge.From] = append(jsonChannel.Adjacency[edge.From], jsonGraphEdge{To: edge.To, Type: edge.Type})
+		}
+		out.Channels = append(out.Channels, jsonChannel)
+	}
+
+	return p.printStructured(OutputJSON, out)
+}
@@ -0,0 +1,172 @@
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func testUpgradeGraph() *list.UpgradeGraph {
+	return &list.UpgradeGraph{
+		Package:        "pkg",
+		DefaultChannel: "stable",
+		Channels: []list.GraphChannel{
+			{
+				Name:    "stable",
+				Default: true,
+				Heads:   []string{"pkg.v1.2.0"},
+				Nodes:   []string{"pkg.v1.0.0", "pkg.v1.1.0", "pkg.v1.2.0"},
+				Edges: []list.GraphEdge{
+					{From: "pkg.v1.0.0", To: "pkg.v1.1.0", Type: list.EdgeReplaces},
+					{From: "pkg.v1.1.0", To: "pkg.v1.2.0", Type: list.EdgeReplaces},
+					{From: "pkg.v1.0.0", To: "pkg.v1.2.0", Type: list.EdgeSkips},
+				},
+			},
+		},
+	}
+}
+
+func TestPrintGraph(t *testing.T) {
+	testCases := []struct {
+		name      string
+		format    string
+		expected  string
+		assertOut func(t *testing.T, out string)
+	}{
+		{
+			name:   "DOT Output",
+			format: OutputDOT,
+			expected: `digraph "pkg" {
+  rankdir=LR;
+  node [shape=box];
+  subgraph "cluster_stable" {
+    label="stable (default)";
+    style=bold;
+    "stable/pkg.v1.0.0" [label="pkg.v1.0.0"];
+    "stable/pkg.v1.1.0" [label="pkg.v1.1.0"];
+    "stable/pkg.v1.2.0" [label="pkg.v1.2.0", style=filled, fillcolor=lightgreen];
+    "stable/pkg.v1.0.0" -> "stable/pkg.v1.1.0";
+    "stable/pkg.v1.1.0" -> "stable/pkg.v1.2.0";
+    "stable/pkg.v1.0.0" -> "stable/pkg.v1.2.0" [style=dashed, label="skips"];
+  }
+}
+`,
+		},
+		{
+			name:   "Mermaid Output",
+			format: OutputMermaid,
+			expected: `graph LR
+  classDef head fill:#90ee90,stroke:#333;
+  subgraph ch0["stable (default)"]
+    n0["pkg.v1.0.0"]
+    n1["pkg.v1.1.0"]
+    n2["pkg.v1.2.0"]
+    n0 --> n1
+    n1 --> n2
+    n0 -. skips .-> n2
+  end
+  class n2 head
+`,
+		},
+		{
+			name:   "JSON Output",
+			format: OutputJSON,
+			assertOut: func(t *testing.T, out string) {
+				var graph struct {
+					Channels []struct {
+						Heads     []string                       `json:"heads"`
+						Adjacency map[string][]map[string]string `json:"adjacency"`
+					} `json:"channels"`
+				}
+				require.NoError(t, json.Unmarshal([]byte(out), &graph))
+				require.Len(t, graph.Channels, 1)
+				assert.Equal(t, []string{"pkg.v1.2.0"}, graph.Channels[0].Heads)
+				assert.Equal(t, []map[string]string{
+					{"to": "pkg.v1.1.0", "type": "replaces"},
+					{"to": "pkg.v1.2.0", "type": "skips"},
+				}, graph.Channels[0].Adjacency["pkg.v1.0.0"])
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf(gomock.Any(), gomock.Any()).Times(1)
+
+			err := p.PrintGraph(testUpgradeGraph(), tc.format)
+			require.NoError(t, err)
+
+			if tc.assertOut != nil {
+				tc.assertOut(t, buf.String())
+				return
+			}
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
+
+func TestPrintGraph_MermaidEdgesOutsideChannel(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	mockLogger.EXPECT().Debugf(gomock.Any(), gomock.Any()).Times(1)
+
+	graph := &list.UpgradeGraph{
+		Package: "pkg",
+		Channels: []list.GraphChannel{
+			{
+				Name:  "fast",
+				Heads: []string{"pkg.v1.2.0"},
+				Nodes: []string{"pkg.v1.2.0"},
+				Edges: []list.GraphEdge{
+					{From: "pkg.v1.1.0", To: "pkg.v1.2.0", Type: list.EdgeReplaces},
+					{From: "pkg.v1.1.1", To: "pkg.v1.2.0", Type: list.EdgeSkips},
+				},
+			},
+		},
+	}
+	err := p.PrintGraph(graph, OutputMermaid)
+	require.NoError(t, err)
+	assert.Equal(t, `graph LR
+  classDef head fill:#90ee90,stroke:#333;
+  subgraph ch0["fast"]
+    n0["pkg.v1.2.0"]
+    n1["pkg.v1.1.0"]
+    n1 --> n0
+    n2["pkg.v1.1.1"]
+    n2 -. skips .-> n0
+  end
+  class n0 head
+`, buf.String())
+}
+
+func TestPrintGraph_UnsupportedFormat(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	mockLogger.EXPECT().Debugf(gomock.Any(), gomock.Any()).Times(1)
+
+	err := p.PrintGraph(testUpgradeGraph(), "svg")
+	assert.EqualError(t, err, `unsupported output format "svg"`)
+}
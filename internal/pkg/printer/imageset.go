@@ -0,0 +1,11 @@
+package printer
+
+import (
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintImageSetConfig prints an oc-mirror image set configuration in YAML or JSON format.
+func (p *Printer) PrintImageSetConfig(config *list.ImageSetConfiguration, format string) error {
+	p.log.Debugf("Printing image set configuration in %s format", format)
+	return p.printStructured(format, config)
+}
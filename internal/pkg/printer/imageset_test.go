@@ -0,0 +1,54 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintImageSetConfig(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	config := &list.ImageSetConfiguration{
+		Kind:       list.ImageSetConfigKind,
+		APIVersion: list.ImageSetConfigAPIVersion,
+		Mirror: list.ImageSetMirror{Operators: []list.ImageSetOperator{{
+			Catalog: "registry.redhat.io/redhat/redhat-operator-index:v4.18",
+			Packages: []list.ImageSetPackage{
+				{Name: "cluster-logging", DefaultChannel: "stable-5.8", Channels: []list.ImageSetChannel{
+					{Name: "stable-5.8", MinVersion: "5.8.1", MaxVersion: "5.8.3"},
+				}},
+				{Name: "jaeger-product"},
+			},
+		}}},
+	}
+	mockLogger.EXPECT().Debugf("Printing image set configuration in %s format", OutputYAML).Times(1)
+
+	err := p.PrintImageSetConfig(config, OutputYAML)
+	assert.NoError(t, err)
+
+	expected := `apiVersion: mirror.openshift.io/v2alpha1
+kind: ImageSetConfiguration
+mirror:
+  operators:
+  - catalog: registry.redhat.io/redhat/redhat-operator-index:v4.18
+    packages:
+    - channels:
+      - maxVersion: 5.8.3
+        minVersion: 5.8.1
+        name: stable-5.8
+      defaultChannel: stable-5.8
+      name: cluster-logging
+    - name: jaeger-product
+`
+	assert.Equal(t, expected, buf.String())
+}
@@ -0,0 +1,28 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintManifests prints Kubernetes manifests ready to be applied, as YAML documents separated by
+// "---" or as a JSON List.
+func (p *Printer) PrintManifests(manifests []list.Manifest, format string) error {
+	p.log.Debugf("Printing %d manifests in %s format", len(manifests), format)
+	switch format {
+	case OutputJSON:
+		return p.printStructured(format, map[string]any{"apiVersion": "v1", "kind": "List", "items": manifests})
+	case OutputYAML:
+		for i, manifest := range manifests {
+			if i > 0 {
+				fmt.Fprintln(p.out, "---")
+			}
+			if err := p.printStructured(format, manifest); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("unsupported output format %q", format)
+}
@@ -0,0 +1,91 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintManifests(t *testing.T) {
+	manifests := []list.Manifest{
+		{APIVersion: "v1", Kind: "Namespace", Metadata: list.ManifestMetadata{Name: "openshift-logging"}},
+		{
+			APIVersion: "operators.coreos.com/v1",
+			Kind:       "OperatorGroup",
+			Metadata:   list.ManifestMetadata{Name: "openshift-logging", Namespace: "openshift-logging"},
+			Spec:       list.OperatorGroupSpec{},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		format        string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:   "YAML Output",
+			format: OutputYAML,
+			expected: "apiVersion: v1\nkind: Namespace\nmetadata:\n  name: openshift-logging\n" +
+				"---\n" +
+				"apiVersion: operators.coreos.com/v1\nkind: OperatorGroup\nmetadata:\n  name: openshift-logging\n  namespace: openshift-logging\nspec: {}\n",
+		},
+		{
+			name:   "JSON Output",
+			format: OutputJSON,
+			expected: `{
+  "apiVersion": "v1",
+  "items": [
+    {
+      "apiVersion": "v1",
+      "kind": "Namespace",
+      "metadata": {
+        "name": "openshift-logging"
+      }
+    },
+    {
+      "apiVersion": "operators.coreos.com/v1",
+      "kind": "OperatorGroup",
+      "metadata": {
+        "name": "openshift-logging",
+        "namespace": "openshift-logging"
+      },
+      "spec": {}
+    }
+  ],
+  "kind": "List"
+}
+`,
+		},
+		{
+			name:          "Unsupported Output",
+			format:        OutputTable,
+			expectedError: `unsupported output format "table"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d manifests in %s format", len(manifests), tc.format).Times(1)
+
+			err := p.PrintManifests(manifests, tc.format)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package printer
+
+import (
+	"encoding/csv"
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// OutputCSV prints comma-separated values, for spreadsheets.
+const OutputCSV = "csv"
+
+// PrintPackageMatrix prints the heads of the channels of a package across OpenShift versions as a
+// table, CSV, JSON or YAML. The first row holds the default channel of each version.
+func (p *Printer) PrintPackageMatrix(matrix *list.PackageMatrix, format string) error {
+	p.log.Debugf("Printing matrix of package %s for %d versions in %s format", matrix.Package, len(matrix.Versions), format)
+	if format != OutputTable && format != OutputCSV {
+		return p.printStructured(format, matrix)
+	}
+
+	header := []string{"CHANNEL"}
+	defaults := []string{"DEFAULT CHANNEL"}
+	for _, v := range matrix.Versions {
+		header = append(header, v.Version)
+		defaults = append(defaults, v.DefaultChannel)
+	}
+	rows := [][]string{header, defaults}
+	for _, ch := range matrix.Channels {
+		row := []string{ch.Name}
+		for _, v := range matrix.Versions {
+			row = append(row, ch.Heads[v.Version])
+		}
+		rows = append(rows, row)
+	}
+
+	if format == OutputCSV {
+		w := csv.NewWriter(p.out)
+		if err := w.WriteAll(rows); err != nil {
+			return fmt.Errorf("failed to write csv output: %w", err)
+		}
+		return nil
+	}
+
+	for _, row := range rows {
+		for i := range row {
+			row[i] = orNone(row[i])
+		}
+		fmt.Fprintln(p.w, strings.Join(row, "\t"))
+	}
+	p.w.Flush()
+	return nil
+}
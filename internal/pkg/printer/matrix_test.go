@@ -0,0 +1,74 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintPackageMatrix(t *testing.T) {
+	matrix := &list.PackageMatrix{
+		Package: "logging",
+		Versions: []list.MatrixVersion{
+			{Version: "4.12", Catalog: "index:v4.12", DefaultChannel: "stable-5.8"},
+			{Version: "4.14", Catalog: "index:v4.14", DefaultChannel: "stable-6.0"},
+		},
+		Channels: []list.MatrixChannel{
+			{Name: "stable-5.8", Heads: map[string]string{"4.12": "logging.v5.8.1", "4.14": "logging.v5.8.2"}},
+			{Name: "stable-6.0", Heads: map[string]string{"4.14": "logging.v6.0.0"}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:   "Table Output",
+			format: OutputTable,
+			expected: "CHANNEL          4.12            4.14\n" +
+				"DEFAULT CHANNEL  stable-5.8      stable-6.0\n" +
+				"stable-5.8       logging.v5.8.1  logging.v5.8.2\n" +
+				"stable-6.0       -               logging.v6.0.0\n",
+		},
+		{
+			name:   "CSV Output",
+			format: OutputCSV,
+			expected: "CHANNEL,4.12,4.14\n" +
+				"DEFAULT CHANNEL,stable-5.8,stable-6.0\n" +
+				"stable-5.8,logging.v5.8.1,logging.v5.8.2\n" +
+				"stable-6.0,,logging.v6.0.0\n",
+		},
+		{
+			name:   "YAML Output",
+			format: OutputYAML,
+			expected: "channels:\n- heads:\n    \"4.12\": logging.v5.8.1\n    \"4.14\": logging.v5.8.2\n  name: stable-5.8\n" +
+				"- heads:\n    \"4.14\": logging.v6.0.0\n  name: stable-6.0\npackage: logging\nversions:\n" +
+				"- catalog: index:v4.12\n  defaultChannel: stable-5.8\n  version: \"4.12\"\n" +
+				"- catalog: index:v4.14\n  defaultChannel: stable-6.0\n  version: \"4.14\"\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing matrix of package %s for %d versions in %s format", matrix.Package, len(matrix.Versions), tc.format).Times(1)
+
+			err := p.PrintPackageMatrix(matrix, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintOCPVersions prints the OpenShift versions published by each catalog source as a
+// matrix of versions by sources, or as JSON or YAML.
+func (p *Printer) PrintOCPVersions(matrix *list.OCPVersionMatrix, format string) error {
+	p.log.Debugf("Printing %d OpenShift versions in %s format", len(matrix.Versions), format)
+	if format != OutputTable {
+		return p.printStructured(format, matrix)
+	}
+
+	header := []string{"VERSION"}
+	for _, source := range matrix.Sources {
+		header = append(header, strings.ToUpper(source.Name))
+	}
+	fmt.Fprintln(p.w, strings.Join(header, "\t"))
+	for _, v := range matrix.Versions {
+		row := []string{v.Version}
+		for _, source := range matrix.Sources {
+			cell := "-"
+			for _, catalog := range v.Catalogs {
+				if catalog.Source == source.Name {
+					cell = "✓"
+					break
+				}
+			}
+			row = append(row, cell)
+		}
+		fmt.Fprintln(p.w, strings.Join(row, "\t"))
+	}
+	p.w.Flush()
+	return nil
+}
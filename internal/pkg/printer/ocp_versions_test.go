@@ -0,0 +1,64 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintOCPVersions(t *testing.T) {
+	matrix := &list.OCPVersionMatrix{
+		Sources: []list.CatalogSource{{Name: "redhat"}, {Name: "certified"}},
+		Versions: []list.OCPVersion{
+			{Version: "4.15", Catalogs: []list.Catalog{{Reference: "redhat-operator-index:v4.15", Source: "redhat"}}},
+			{Version: "4.16", Catalogs: []list.Catalog{
+				{Reference: "redhat-operator-index:v4.16", Source: "redhat"},
+				{Reference: "certified-operator-index:v4.16", Source: "certified"},
+			}},
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:   "Table Output",
+			format: OutputTable,
+			expected: "VERSION  REDHAT  CERTIFIED\n" +
+				"4.15     ✓       -\n" +
+				"4.16     ✓       ✓\n",
+		},
+		{
+			name:   "YAML Output",
+			format: OutputYAML,
+			expected: "sources:\n- name: redhat\n  repository: \"\"\n- name: certified\n  repository: \"\"\nversions:\n" +
+				"- catalogs:\n  - reference: redhat-operator-index:v4.15\n    source: redhat\n  version: \"4.15\"\n" +
+				"- catalogs:\n  - reference: redhat-operator-index:v4.16\n    source: redhat\n  - reference: certified-operator-index:v4.16\n    source: certified\n  version: \"4.16\"\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d OpenShift versions in %s format", len(matrix.Versions), tc.format).Times(1)
+
+			err := p.PrintOCPVersions(matrix, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
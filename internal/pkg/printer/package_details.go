@@ -0,0 +1,39 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintPackageDetails prints the installation metadata of a package as a table, JSON or YAML.
+func (p *Printer) PrintPackageDetails(details *list.PackageDetails, format string) error {
+	p.log.Debugf("Printing details of package %s in %s format", details.Name, format)
+	if format != OutputTable {
+		return p.printStructured(format, details)
+	}
+
+	fmt.Fprintf(p.w, "Name:\t%s\n", details.Name)
+	fmt.Fprintf(p.w, "Display Name:\t%s\n", orNone(details.DisplayName))
+	fmt.Fprintf(p.w, "Provider:\t%s\n", orNone(details.Provider))
+	fmt.Fprintf(p.w, "Default Channel:\t%s\n", details.DefaultChannel)
+	fmt.Fprintf(p.w, "Channels:\t%s\n", orNone(strings.Join(details.Channels, ",")))
+	fmt.Fprintf(p.w, "Channel:\t%s\n", details.Channel)
+	fmt.Fprintf(p.w, "Head:\t%s\n", details.Head)
+	fmt.Fprintf(p.w, "Head Version:\t%s\n", orNone(details.HeadVersion))
+	fmt.Fprintf(p.w, "Capability Level:\t%s\n", orNone(details.CapabilityLevel))
+	fmt.Fprintf(p.w, "Suggested Namespace:\t%s\n", orNone(details.SuggestedNamespace))
+	if details.Deprecation != "" {
+		fmt.Fprintf(p.w, "Deprecated:\t%s\n", details.Deprecation)
+	}
+	p.w.Flush()
+
+	fmt.Fprintln(p.out, "\nInstall Modes:")
+	fmt.Fprintln(p.w, "  TYPE\tSUPPORTED")
+	for _, mode := range details.InstallModes {
+		fmt.Fprintf(p.w, "  %s\t%t\n", mode.Type, mode.Supported)
+	}
+	p.w.Flush()
+	return nil
+}
@@ -0,0 +1,80 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintPackageDetails(t *testing.T) {
+	details := &list.PackageDetails{
+		Name:           "logging",
+		DisplayName:    "Logging",
+		DefaultChannel: "stable",
+		Channels:       []string{"stable", "fast"},
+		Channel:        "stable",
+		Head:           "logging.v1.1.0",
+		HeadVersion:    "1.1.0",
+		InstallModes: []v1alpha1.InstallMode{
+			{Type: v1alpha1.InstallModeTypeOwnNamespace, Supported: true},
+			{Type: v1alpha1.InstallModeTypeAllNamespaces, Supported: false},
+		},
+		SuggestedNamespace: "openshift-logging",
+		CapabilityLevel:    "Seamless Upgrades",
+	}
+
+	testCases := []struct {
+		name     string
+		format   string
+		expected string
+	}{
+		{
+			name:   "Table Output",
+			format: OutputTable,
+			expected: "Name:                 logging\n" +
+				"Display Name:         Logging\n" +
+				"Provider:             -\n" +
+				"Default Channel:      stable\n" +
+				"Channels:             stable,fast\n" +
+				"Channel:              stable\n" +
+				"Head:                 logging.v1.1.0\n" +
+				"Head Version:         1.1.0\n" +
+				"Capability Level:     Seamless Upgrades\n" +
+				"Suggested Namespace:  openshift-logging\n" +
+				"\nInstall Modes:\n" +
+				"  TYPE           SUPPORTED\n" +
+				"  OwnNamespace   true\n" +
+				"  AllNamespaces  false\n",
+		},
+		{
+			name:   "YAML Output",
+			format: OutputYAML,
+			expected: "capabilityLevel: Seamless Upgrades\nchannel: stable\nchannels:\n- stable\n- fast\ndefaultChannel: stable\n" +
+				"displayName: Logging\nhead: logging.v1.1.0\nheadVersion: 1.1.0\ninstallModes:\n- supported: true\n  type: OwnNamespace\n" +
+				"- supported: false\n  type: AllNamespaces\nname: logging\nsuggestedNamespace: openshift-logging\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing details of package %s in %s format", details.Name, tc.format).Times(1)
+
+			err := p.PrintPackageDetails(details, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -3,8 +3,12 @@ package printer
 import (
 	"fmt"
 	"io"
+	"slices"
+	"strings"
 	"text/tabwriter"
+	"time"
 
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
 	"github.com/aguidirh/lumen/internal/pkg/list"
 )
 
@@ -26,40 +30,119 @@ func NewPrinter(out io.Writer, log Logger) *Printer {
 }
 
 // PrintCatalogs formats and prints the list of available catalogs.
-func (p *Printer) PrintCatalogs(ocpVersion string, catalogs []string) {
+func (p *Printer) PrintCatalogs(ocpVersion string, catalogs []list.Catalog) {
 	p.log.Debugf("Printing %d catalogs for OCP version %s", len(catalogs), ocpVersion)
 	fmt.Fprintf(p.out, "OpenShift %s Operator Catalogs:\n\n", ocpVersion)
+	fmt.Fprintln(p.w, "SOURCE\tLABEL\tCATALOG\tDESCRIPTION")
 	for _, catalog := range catalogs {
-		fmt.Fprintln(p.out, catalog)
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\n", catalog.Source, orNone(catalog.Label), catalog.Reference, orNone(catalog.Description))
 	}
+	p.w.Flush()
 }
 
 // PrintPackages formats and prints the list of packages in a table.
-func (p *Printer) PrintPackages(packages []list.Package) {
+// When wide is set, the architectures and operating systems of the default channel head are printed as well.
+// A deprecation column is added when any package is deprecated.
+func (p *Printer) PrintPackages(packages []list.Package, wide bool) {
 	p.log.Debugf("Printing %d packages", len(packages))
-	fmt.Fprintln(p.w, "NAME\tDEFAULT CHANNEL")
+	deprecated := slices.ContainsFunc(packages, func(pkg list.Package) bool { return pkg.Deprecation != "" })
+	if !wide {
+		fmt.Fprintln(p.w, "NAME\tDEFAULT CHANNEL"+deprecationHeader(deprecated))
+		for _, pkg := range packages {
+			fmt.Fprintf(p.w, "%s\t%s%s\n", pkg.Name, pkg.DefaultChannel, deprecationCell(deprecated, pkg.Deprecation))
+		}
+		p.w.Flush()
+		return
+	}
+
+	fmt.Fprintln(p.w, "NAME\tDEFAULT CHANNEL\tARCH\tOS"+deprecationHeader(deprecated))
 	for _, pkg := range packages {
-		fmt.Fprintf(p.w, "%s\t%s\n", pkg.Name, pkg.DefaultChannel)
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s%s\n",
+			pkg.Name,
+			pkg.DefaultChannel,
+			orNone(strings.Join(pkg.Architectures, ",")),
+			orNone(strings.Join(pkg.OperatingSystems, ",")),
+			deprecationCell(deprecated, pkg.Deprecation),
+		)
 	}
 	p.w.Flush()
 }
 
 // PrintChannels formats and prints the list of channels for a package.
+// A deprecation column is added when any channel is deprecated.
 func (p *Printer) PrintChannels(channels []list.Channel) {
 	p.log.Debugf("Printing %d channels", len(channels))
-	fmt.Fprintln(p.w, "NAME\tHEAD")
+	deprecated := slices.ContainsFunc(channels, func(ch list.Channel) bool { return ch.Deprecation != "" })
+	fmt.Fprintln(p.w, "NAME\tHEAD"+deprecationHeader(deprecated))
 	for _, ch := range channels {
-		fmt.Fprintf(p.w, "%s\t%s\n", ch.Name, ch.Head)
+		fmt.Fprintf(p.w, "%s\t%s%s\n", ch.Name, ch.Head, deprecationCell(deprecated, ch.Deprecation))
 	}
 	p.w.Flush()
 }
 
 // PrintBundles formats and prints the list of bundle versions in a channel.
-func (p *Printer) PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry) {
+// When wide is set, the version, upgrade edges, architectures, operating systems and bundle image
+// are printed as well.
+// A deprecation column is added when any bundle is deprecated.
+func (p *Printer) PrintBundles(pkgName, channelName string, bundles []list.ChannelEntry, wide bool) {
 	p.log.Debugf("Printing %d bundles for package %s, channel %s", len(bundles), pkgName, channelName)
-	fmt.Fprintln(p.w, "BUNDLE_VERSION")
+	deprecated := slices.ContainsFunc(bundles, func(b list.ChannelEntry) bool { return b.Deprecation != "" })
+	if !wide {
+		fmt.Fprintln(p.w, "BUNDLE_VERSION"+deprecationHeader(deprecated))
+		for _, bundle := range bundles {
+			fmt.Fprintf(p.w, "%s%s\n", bundle.Name, deprecationCell(deprecated, bundle.Deprecation))
+		}
+		p.w.Flush()
+		return
+	}
+
+	fmt.Fprintln(p.w, "BUNDLE_VERSION\tVERSION\tREPLACES\tSKIPS\tSKIP RANGE\tARCH\tOS\tIMAGE"+deprecationHeader(deprecated))
 	for _, bundle := range bundles {
-		fmt.Fprintf(p.w, "%s\n", bundle.Name)
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s%s\n",
+			bundle.Name,
+			orNone(bundle.Version),
+			orNone(bundle.Replaces),
+			orNone(strings.Join(bundle.Skips, ",")),
+			orNone(bundle.SkipRange),
+			orNone(strings.Join(bundle.Architectures, ",")),
+			orNone(strings.Join(bundle.OperatingSystems, ",")),
+			orNone(bundle.Image),
+			deprecationCell(deprecated, bundle.Deprecation),
+		)
+	}
+	p.w.Flush()
+}
+
+// PrintCacheEntries formats and prints the list of cached catalogs in a table.
+func (p *Printer) PrintCacheEntries(entries []catalog.CacheEntry) {
+	p.log.Debugf("Printing %d cache entries", len(entries))
+	fmt.Fprintln(p.w, "CATALOG\tDIGEST\tCACHED AT")
+	for _, entry := range entries {
+		fmt.Fprintf(p.w, "%s\t%s\t%s\n", entry.Reference, entry.Digest, entry.CachedAt.Format(time.RFC3339))
 	}
 	p.w.Flush()
 }
+
+// deprecationHeader returns the header of the deprecation column, if the table has one.
+func deprecationHeader(deprecated bool) string {
+	if !deprecated {
+		return ""
+	}
+	return "\tDEPRECATED"
+}
+
+// deprecationCell returns the deprecation column cell of a row, if the table has one.
+func deprecationCell(deprecated bool, message string) string {
+	if !deprecated {
+		return ""
+	}
+	return "\t" + orNone(message)
+}
+
+// orNone returns a placeholder for empty table cells so columns stay aligned.
+func orNone(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -4,7 +4,9 @@ import (
 	"bytes"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/aguidirh/lumen/internal/pkg/catalog"
 	"github.com/aguidirh/lumen/internal/pkg/list"
 	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
 	"github.com/stretchr/testify/assert"
@@ -15,6 +17,7 @@ func TestPrintPackages(t *testing.T) {
 	testCases := []struct {
 		name           string
 		packages       []list.Package
+		wide           bool
 		expectedLog    string
 		expectedOutput string
 	}{
@@ -42,6 +45,25 @@ func TestPrintPackages(t *testing.T) {
 			expectedLog:    "Printing 2 packages",
 			expectedOutput: "NAME                             DEFAULT CHANNEL\na-very-very-long-package-name  alpha\nshort                            stable\n",
 		},
+		{
+			name: "Success Case - Deprecated Package",
+			packages: []list.Package{
+				{Name: "pkg1", DefaultChannel: "stable", Deprecation: "use pkg2"},
+				{Name: "pkg2", DefaultChannel: "stable"},
+			},
+			expectedLog:    "Printing 2 packages",
+			expectedOutput: "NAME  DEFAULT CHANNEL  DEPRECATED\npkg1  stable  use pkg2\npkg2  stable  -\n",
+		},
+		{
+			name: "Success Case - Wide",
+			packages: []list.Package{
+				{Name: "pkg1", DefaultChannel: "stable", Architectures: []string{"amd64", "arm64"}, OperatingSystems: []string{"linux"}},
+				{Name: "pkg2", DefaultChannel: "stable"},
+			},
+			wide:           true,
+			expectedLog:    "Printing 2 packages",
+			expectedOutput: "NAME  DEFAULT CHANNEL  ARCH  OS\npkg1  stable  amd64,arm64  linux\npkg2  stable  -  -\n",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -62,7 +84,7 @@ func TestPrintPackages(t *testing.T) {
 			}).Times(1)
 
 			// 3. Execution
-			p.PrintPackages(tc.packages)
+			p.PrintPackages(tc.packages, tc.wide)
 
 			// 4. Assertion
 			// Compare line by line to be robust against spacing issues.
@@ -86,7 +108,10 @@ func TestPrintCatalogs(t *testing.T) {
 	mockLogger := mock.NewMockLogger(mockCtrl)
 	p := NewPrinter(&buf, mockLogger)
 
-	catalogs := []string{"catalog1", "catalog2"}
+	catalogs := []list.Catalog{
+		{Reference: "catalog1:v4.16", Source: "one", Label: "Catalog One", Description: "The first catalog"},
+		{Reference: "catalog2:v4.16", Source: "two"},
+	}
 	ocpVersion := "4.16"
 
 	// 2. Expectations
@@ -96,7 +121,10 @@ func TestPrintCatalogs(t *testing.T) {
 	p.PrintCatalogs(ocpVersion, catalogs)
 
 	// 4. Assertion
-	expectedOutput := "OpenShift 4.16 Operator Catalogs:\n\ncatalog1\ncatalog2\n"
+	expectedOutput := "OpenShift 4.16 Operator Catalogs:\n\n" +
+		"SOURCE  LABEL        CATALOG         DESCRIPTION\n" +
+		"one     Catalog One  catalog1:v4.16  The first catalog\n" +
+		"two     -            catalog2:v4.16  -\n"
 	assert.Contains(t, buf.String(), expectedOutput)
 }
 
@@ -139,9 +167,102 @@ func TestPrintBundles(t *testing.T) {
 	mockLogger.EXPECT().Debugf("Printing %d bundles for package %s, channel %s", len(bundles), pkgName, channelName).Times(1)
 
 	// 3. Execution
-	p.PrintBundles(pkgName, channelName, bundles)
+	p.PrintBundles(pkgName, channelName, bundles, false)
 
 	// 4. Assertion
 	expectedTable := "BUNDLE_VERSION\nbundle-1.0.0\nbundle-1.1.0\n"
 	assert.Equal(t, strings.TrimSpace(expectedTable), strings.TrimSpace(buf.String()))
 }
+
+func TestPrintBundles_Wide(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	bundles := []list.ChannelEntry{
+		{Name: "pkg.v1.0.0", Version: "1.0.0", Image: "quay.io/pkg:v1.0.0"},
+		{Name: "pkg.v1.1.0", Version: "1.1.0", Image: "quay.io/pkg:v1.1.0", Replaces: "pkg.v1.0.0", Skips: []string{"pkg.v1.0.1", "pkg.v1.0.2"}, SkipRange: "<1.1.0", Architectures: []string{"amd64", "arm64"}, OperatingSystems: []string{"linux"}},
+	}
+
+	// 2. Expectations
+	mockLogger.EXPECT().Debugf("Printing %d bundles for package %s, channel %s", len(bundles), "pkg", "stable").Times(1)
+
+	// 3. Execution
+	p.PrintBundles("pkg", "stable", bundles, true)
+
+	// 4. Assertion
+	expectedLines := []string{
+		"BUNDLE_VERSION VERSION REPLACES SKIPS SKIP RANGE ARCH OS IMAGE",
+		"pkg.v1.0.0 1.0.0 - - - - - quay.io/pkg:v1.0.0",
+		"pkg.v1.1.0 1.1.0 pkg.v1.0.0 pkg.v1.0.1,pkg.v1.0.2 <1.1.0 amd64,arm64 linux quay.io/pkg:v1.1.0",
+	}
+	actualLines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	assert.Equal(t, len(expectedLines), len(actualLines))
+	for i := range expectedLines {
+		assert.Equal(t, expectedLines[i], strings.Join(strings.Fields(actualLines[i]), " "))
+	}
+}
+
+func TestPrintCacheEntries(t *testing.T) {
+	// 1. Setup
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	cachedAt := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	entries := []catalog.CacheEntry{
+		{Reference: "registry.redhat.io/redhat/redhat-operator-index:v4.16", Digest: "sha256:abc", CachedAt: cachedAt},
+	}
+
+	// 2. Expectations
+	mockLogger.EXPECT().Debugf("Printing %d cache entries", len(entries)).Times(1)
+
+	// 3. Execution
+	p.PrintCacheEntries(entries)
+
+	// 4. Assertion
+	expectedTable := "CATALOG                                                DIGEST      CACHED AT\n" +
+		"registry.redhat.io/redhat/redhat-operator-index:v4.16  sha256:abc  2025-06-01T12:00:00Z\n"
+	assert.Equal(t, strings.TrimSpace(expectedTable), strings.TrimSpace(buf.String()))
+}
+
+func TestPrintChannels_Deprecated(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	channels := []list.Channel{{Name: "stable", Head: "v1.0.0"}, {Name: "beta", Head: "v1.1.0", Deprecation: "use stable"}}
+	mockLogger.EXPECT().Debugf("Printing %d channels", len(channels)).Times(1)
+
+	p.PrintChannels(channels)
+
+	expectedOutput := "NAME    HEAD    DEPRECATED\nstable  v1.0.0  -\nbeta    v1.1.0  use stable\n"
+	assert.Equal(t, strings.TrimSpace(expectedOutput), strings.TrimSpace(buf.String()))
+}
+
+func TestPrintBundles_Deprecated(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	var buf bytes.Buffer
+	mockLogger := mock.NewMockLogger(mockCtrl)
+	p := NewPrinter(&buf, mockLogger)
+
+	bundles := []list.ChannelEntry{{Name: "pkg.v1.0.0", Deprecation: "data loss bug"}, {Name: "pkg.v1.1.0"}}
+	mockLogger.EXPECT().Debugf("Printing %d bundles for package %s, channel %s", len(bundles), "pkg", "stable").Times(1)
+
+	p.PrintBundles("pkg", "stable", bundles, false)
+
+	expectedOutput := "BUNDLE_VERSION  DEPRECATED\npkg.v1.0.0      data loss bug\npkg.v1.1.0      -\n"
+	assert.Equal(t, strings.TrimSpace(expectedOutput), strings.TrimSpace(buf.String()))
+}
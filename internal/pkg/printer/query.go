@@ -0,0 +1,23 @@
+package printer
+
+import (
+	"fmt"
+)
+
+// PrintQueryResults prints the outputs of a catalog query. As jq does, each output is printed as
+// its own JSON document, or as its own YAML document separated by "---".
+func (p *Printer) PrintQueryResults(results []any, format string) error {
+	p.log.Debugf("Printing %d query results in %s format", len(results), format)
+	if format != OutputJSON && format != OutputYAML {
+		return fmt.Errorf("unsupported output format %q", format)
+	}
+	for i, result := range results {
+		if format == OutputYAML && i > 0 {
+			fmt.Fprintln(p.out, "---")
+		}
+		if err := p.printStructured(format, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintQueryResults(t *testing.T) {
+	results := []any{
+		"logging.v1.2.0",
+		map[string]any{"name": "logging", "channels": []any{"stable"}},
+	}
+
+	testCases := []struct {
+		name          string
+		format        string
+		expected      string
+		expectedError string
+	}{
+		{
+			name:     "JSON Output",
+			format:   OutputJSON,
+			expected: "\"logging.v1.2.0\"\n{\n  \"channels\": [\n    \"stable\"\n  ],\n  \"name\": \"logging\"\n}\n",
+		},
+		{
+			name:     "YAML Output",
+			format:   OutputYAML,
+			expected: "logging.v1.2.0\n---\nchannels:\n- stable\nname: logging\n",
+		},
+		{
+			name:          "Unsupported Output",
+			format:        OutputTable,
+			expectedError: `unsupported output format "table"`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d query results in %s format", len(results), tc.format).Times(1)
+
+			err := p.PrintQueryResults(results, tc.format)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
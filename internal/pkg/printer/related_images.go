@@ -0,0 +1,42 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// Output formats accepted by PrintRelatedImages in addition to json and yaml.
+const (
+	OutputPlain   = "plain"
+	OutputMapping = "mapping"
+)
+
+// PrintRelatedImages prints related images one per line, as JSON or YAML, or as a
+// source=destination mapping file for oc image mirror targeting mirrorRegistry.
+func (p *Printer) PrintRelatedImages(images []list.RelatedImage, format, mirrorRegistry string) error {
+	p.log.Debugf("Printing %d related images in %s format", len(images), format)
+	switch format {
+	case OutputPlain:
+		for _, img := range images {
+			fmt.Fprintln(p.out, img.Image)
+		}
+	case OutputMapping:
+		if mirrorRegistry == "" {
+			return fmt.Errorf("a mirror registry is required for the %s output", OutputMapping)
+		}
+		for _, img := range images {
+			dest, err := list.MirrorDestination(img.Image, mirrorRegistry)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(p.out, "%s=%s\n", img.Image, dest)
+		}
+	default:
+		if images == nil {
+			images = []list.RelatedImage{}
+		}
+		return p.printStructured(format, images)
+	}
+	return nil
+}
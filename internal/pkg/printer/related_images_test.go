@@ -0,0 +1,88 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintRelatedImages(t *testing.T) {
+	images := []list.RelatedImage{
+		{Image: "quay.io/example/operator@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11", Names: []string{"operator"}, Bundles: []string{"pkg1.v1.0.0"}},
+		{Image: "quay.io/example/pkg1-bundle:v1.0.0", Bundles: []string{"pkg1.v1.0.0"}},
+	}
+
+	testCases := []struct {
+		name           string
+		format         string
+		mirrorRegistry string
+		expected       string
+		expectedError  string
+	}{
+		{
+			name:     "Plain Output",
+			format:   OutputPlain,
+			expected: "quay.io/example/operator@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11\nquay.io/example/pkg1-bundle:v1.0.0\n",
+		},
+		{
+			name:           "Mapping Output",
+			format:         OutputMapping,
+			mirrorRegistry: "mirror.example.com",
+			expected: "quay.io/example/operator@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11=mirror.example.com/example/operator\n" +
+				"quay.io/example/pkg1-bundle:v1.0.0=mirror.example.com/example/pkg1-bundle:v1.0.0\n",
+		},
+		{
+			name:          "Mapping Output - Missing Mirror Registry",
+			format:        OutputMapping,
+			expectedError: "a mirror registry is required for the mapping output",
+		},
+		{
+			name:   "JSON Output",
+			format: OutputJSON,
+			expected: `[
+  {
+    "image": "quay.io/example/operator@sha256:5b0d1d8f2f0c2c2bf0d8bb7c9bcb7b6a8e8d0a53f27a2c7c0a3f7b0e0d3c9a11",
+    "names": [
+      "operator"
+    ],
+    "bundles": [
+      "pkg1.v1.0.0"
+    ]
+  },
+  {
+    "image": "quay.io/example/pkg1-bundle:v1.0.0",
+    "bundles": [
+      "pkg1.v1.0.0"
+    ]
+  }
+]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d related images in %s format", len(images), tc.format).Times(1)
+
+			err := p.PrintRelatedImages(images, tc.format, tc.mirrorRegistry)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
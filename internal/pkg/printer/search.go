@@ -0,0 +1,30 @@
+package printer
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintSearchResults prints the packages matching a search query as a table, JSON or YAML.
+func (p *Printer) PrintSearchResults(results []list.SearchResult, format string) error {
+	p.log.Debugf("Printing %d search results in %s format", len(results), format)
+	if format != OutputTable {
+		if results == nil {
+			results = []list.SearchResult{}
+		}
+		return p.printStructured(format, results)
+	}
+
+	if len(results) == 0 {
+		fmt.Fprintln(p.out, "No matching packages found.")
+		return nil
+	}
+	fmt.Fprintln(p.w, "SCORE\tPACKAGE\tDISPLAY NAME\tPROVIDER\tDEFAULT CHANNEL\tMATCHED\tCATALOG")
+	for _, r := range results {
+		fmt.Fprintf(p.w, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Score, r.Package, orNone(r.DisplayName), orNone(r.Provider), r.DefaultChannel, strings.Join(r.MatchedFields, ","), r.Catalog)
+	}
+	p.w.Flush()
+	return nil
+}
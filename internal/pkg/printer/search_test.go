@@ -0,0 +1,70 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintSearchResults(t *testing.T) {
+	results := []list.SearchResult{
+		{Catalog: "catalog:v4.15", Package: "cluster-logging", DisplayName: "Red Hat OpenShift Logging", Provider: "Red Hat", DefaultChannel: "stable", Score: 40, MatchedFields: []string{"name"}},
+		{Catalog: "catalog:v4.15", Package: "loki-operator", DefaultChannel: "stable", Score: 20, MatchedFields: []string{"categories"}},
+	}
+
+	testCases := []struct {
+		name     string
+		results  []list.SearchResult
+		format   string
+		expected string
+	}{
+		{
+			name:    "Table Output",
+			results: results,
+			format:  OutputTable,
+			expected: "SCORE  PACKAGE          DISPLAY NAME               PROVIDER  DEFAULT CHANNEL  MATCHED     CATALOG\n" +
+				"40     cluster-logging  Red Hat OpenShift Logging  Red Hat   stable           name        catalog:v4.15\n" +
+				"20     loki-operator    -                          -         stable           categories  catalog:v4.15\n",
+		},
+		{
+			name:     "Table Output - No Results",
+			format:   OutputTable,
+			expected: "No matching packages found.\n",
+		},
+		{
+			name:     "JSON Output - No Results",
+			format:   OutputJSON,
+			expected: "[]\n",
+		},
+		{
+			name:    "YAML Output",
+			results: results[1:],
+			format:  OutputYAML,
+			expected: "- catalog: catalog:v4.15\n  defaultChannel: stable\n  matchedFields:\n  - categories\n" +
+				"  package: loki-operator\n  score: 20\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d search results in %s format", len(tc.results), tc.format).Times(1)
+
+			err := p.PrintSearchResults(tc.results, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
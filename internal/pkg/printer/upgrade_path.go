@@ -0,0 +1,27 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintUpgradePath prints the hops of an upgrade path as a table, JSON or YAML.
+func (p *Printer) PrintUpgradePath(path *list.UpgradePath, format string) error {
+	p.log.Debugf("Printing upgrade path with %d hops in %s format", len(path.Hops), format)
+	if format != OutputTable {
+		return p.printStructured(format, path)
+	}
+
+	fmt.Fprintf(p.out, "Upgrade path for %s in channel %s from %s to %s:\n\n", path.Package, path.Channel, path.From, path.To)
+	if len(path.Hops) == 0 {
+		fmt.Fprintln(p.out, "Already at the target bundle, no upgrade needed.")
+		return nil
+	}
+	fmt.Fprintln(p.w, "STEP\tFROM\tTO\tVIA")
+	for i, hop := range path.Hops {
+		fmt.Fprintf(p.w, "%d\t%s\t%s\t%s\n", i+1, hop.From, hop.To, hop.Type)
+	}
+	p.w.Flush()
+	return nil
+}
@@ -0,0 +1,72 @@
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintUpgradePath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     *list.UpgradePath
+		format   string
+		expected string
+	}{
+		{
+			name: "Table Output",
+			path: &list.UpgradePath{
+				Package: "pkg",
+				Channel: "stable",
+				From:    "pkg.v1.0.0",
+				To:      "pkg.v1.2.0",
+				Hops: []list.GraphEdge{
+					{From: "pkg.v1.0.0", To: "pkg.v1.1.0", Type: list.EdgeReplaces},
+					{From: "pkg.v1.1.0", To: "pkg.v1.2.0", Type: list.EdgeSkipRange},
+				},
+			},
+			format: OutputTable,
+			expected: "Upgrade path for pkg in channel stable from pkg.v1.0.0 to pkg.v1.2.0:\n\n" +
+				"STEP  FROM        TO          VIA\n" +
+				"1     pkg.v1.0.0  pkg.v1.1.0  replaces\n" +
+				"2     pkg.v1.1.0  pkg.v1.2.0  skipRange\n",
+		},
+		{
+			name:   "Table Output - No Hops",
+			path:   &list.UpgradePath{Package: "pkg", Channel: "stable", From: "pkg.v1.2.0", To: "pkg.v1.2.0", Hops: []list.GraphEdge{}},
+			format: OutputTable,
+			expected: "Upgrade path for pkg in channel stable from pkg.v1.2.0 to pkg.v1.2.0:\n\n" +
+				"Already at the target bundle, no upgrade needed.\n",
+		},
+		{
+			name:   "YAML Output",
+			path:   &list.UpgradePath{Package: "pkg", Channel: "stable", From: "pkg.v1.0.0", To: "pkg.v1.1.0", Hops: []list.GraphEdge{{From: "pkg.v1.0.0", To: "pkg.v1.1.0", Type: list.EdgeReplaces}}},
+			format: OutputYAML,
+			expected: "channel: stable\nfrom: pkg.v1.0.0\nhops:\n- from: pkg.v1.0.0\n  to: pkg.v1.1.0\n  type: replaces\n" +
+				"package: pkg\nto: pkg.v1.1.0\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing upgrade path with %d hops in %s format", len(tc.path.Hops), tc.format).Times(1)
+
+			err := p.PrintUpgradePath(tc.path, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, strings.TrimSpace(tc.expected), strings.TrimSpace(buf.String()))
+		})
+	}
+}
@@ -0,0 +1,30 @@
+package printer
+
+import (
+	"fmt"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+)
+
+// PrintPackageLocations prints the catalogs containing a package as a table, JSON or YAML.
+func (p *Printer) PrintPackageLocations(locations *list.PackageLocations, format string) error {
+	p.log.Debugf("Printing %d locations of package %s in %s format", len(locations.Locations), locations.Package, format)
+	if format != OutputTable {
+		return p.printStructured(format, locations)
+	}
+
+	if len(locations.Locations) == 0 {
+		fmt.Fprintf(p.out, "Package %s was not found in any OpenShift %s catalog.\n", locations.Package, locations.OCPVersion)
+		return nil
+	}
+	fmt.Fprintln(p.w, "SOURCE\tDEFAULT CHANNEL\tHEAD\tVERSION\tCATALOG")
+	for _, l := range locations.Locations {
+		fmt.Fprintf(p.w, "%s\t%s\t%s\t%s\t%s\n", l.Source, l.DefaultChannel, orNone(l.Head), orNone(l.HeadVersion), l.Catalog)
+	}
+	p.w.Flush()
+
+	if locations.Duplicate {
+		fmt.Fprintf(p.out, "\nWarning: package %s is shipped by %d catalogs, make sure the Subscription uses the intended source.\n", locations.Package, len(locations.Locations))
+	}
+	return nil
+}
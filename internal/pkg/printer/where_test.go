@@ -0,0 +1,70 @@
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/aguidirh/lumen/internal/pkg/list"
+	"github.com/aguidirh/lumen/internal/pkg/printer/mock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestPrintPackageLocations(t *testing.T) {
+	locations := &list.PackageLocations{
+		Package:    "grafana-operator",
+		OCPVersion: "4.16",
+		Locations: []list.PackageLocation{
+			{Catalog: "redhat-index:v4.16", Source: "redhat", DefaultChannel: "stable", Head: "grafana-operator.v5.0.0", HeadVersion: "5.0.0"},
+			{Catalog: "community-index:v4.16", Source: "community", DefaultChannel: "v5"},
+		},
+		Duplicate: true,
+	}
+
+	testCases := []struct {
+		name      string
+		locations *list.PackageLocations
+		format    string
+		expected  string
+	}{
+		{
+			name:      "Table Output - Duplicate",
+			locations: locations,
+			format:    OutputTable,
+			expected: "SOURCE     DEFAULT CHANNEL  HEAD                     VERSION  CATALOG\n" +
+				"redhat     stable           grafana-operator.v5.0.0  5.0.0    redhat-index:v4.16\n" +
+				"community  v5               -                        -        community-index:v4.16\n" +
+				"\nWarning: package grafana-operator is shipped by 2 catalogs, make sure the Subscription uses the intended source.\n",
+		},
+		{
+			name:      "Table Output - Not Found",
+			locations: &list.PackageLocations{Package: "grafana-operator", OCPVersion: "4.16"},
+			format:    OutputTable,
+			expected:  "Package grafana-operator was not found in any OpenShift 4.16 catalog.\n",
+		},
+		{
+			name:      "JSON Output",
+			locations: &list.PackageLocations{Package: "grafana-operator", OCPVersion: "4.16", Locations: []list.PackageLocation{}},
+			format:    OutputJSON,
+			expected:  "{\n  \"package\": \"grafana-operator\",\n  \"ocpVersion\": \"4.16\",\n  \"locations\": [],\n  \"duplicate\": false\n}\n",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			var buf bytes.Buffer
+			mockLogger := mock.NewMockLogger(mockCtrl)
+			p := NewPrinter(&buf, mockLogger)
+
+			mockLogger.EXPECT().Debugf("Printing %d locations of package %s in %s format", len(tc.locations.Locations), tc.locations.Package, tc.format).Times(1)
+
+			err := p.PrintPackageLocations(tc.locations, tc.format)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, buf.String())
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 
 	"github.com/aguidirh/lumen/internal/mcphandler"
+	"github.com/aguidirh/lumen/internal/pkg/list"
 )
 
 // MCPRequest represents an incoming MCP tool call request
@@ -75,7 +76,7 @@ func handleRequest(request MCPRequest) MCPResponse {
 			"tools": []map[string]interface{}{
 				{
 					"name":        "lumen_list",
-					"description": "Introspects an operator-framework catalog image to list its contents. Can list all packages (operators), all channels for a given package, or all bundle versions for a given channel.",
+					"description": "Introspects an operator-framework catalog image to list its contents. Can list all packages (operators), all channels for a given package, or all bundle versions for a given channel, sorted by semantic version, oldest first.",
 					"inputSchema": map[string]interface{}{
 						"type": "object",
 						"properties": map[string]interface{}{
@@ -99,6 +100,18 @@ func handleRequest(request MCPRequest) MCPResponse {
 								"type":        "boolean",
 								"description": "Set to true to discover a list of available Red Hat catalogs for a given OpenShift version.",
 							},
+							"minVersion": map[string]interface{}{
+								"type":        "string",
+								"description": "When listing the bundles of a channel, only return bundles with this version or newer (e.g., '1.2.0').",
+							},
+							"maxVersion": map[string]interface{}{
+								"type":        "string",
+								"description": "When listing the bundles of a channel, only return bundles with this version or older (e.g., '1.4.0').",
+							},
+							"latest": map[string]interface{}{
+								"type":        "integer",
+								"description": "When listing the bundles of a channel, only return the given number of newest bundles.",
+							},
 						},
 					},
 				},
@@ -141,8 +154,13 @@ func handleToolCall(request MCPRequest) MCPResponse {
 	packageName := getStringArg(arguments, "packageName", "")
 	channelName := getStringArg(arguments, "channelName", "")
 	listCatalogs := getBoolArg(arguments, "listCatalogs", false)
+	bundleFilter := list.BundleFilter{
+		MinVersion: getStringArg(arguments, "minVersion", ""),
+		MaxVersion: getStringArg(arguments, "maxVersion", ""),
+		Latest:     getIntArg(arguments, "latest", 0),
+	}
 
-	result, err := mcphandler.LumenToolHandler(catalogRef, ocpVersion, packageName, channelName, listCatalogs)
+	result, err := mcphandler.LumenToolHandler(catalogRef, ocpVersion, packageName, channelName, listCatalogs, bundleFilter)
 	if err != nil {
 		response.Error = &MCPError{Code: -32603, Message: fmt.Sprintf("Tool execution failed: %v", err)}
 		return response
@@ -173,3 +191,13 @@ func getBoolArg(args map[string]interface{}, key string, defaultValue bool) bool
 	}
 	return defaultValue
 }
+
+func getIntArg(args map[string]interface{}, key string, defaultValue int) int {
+	if val, ok := args[key]; ok {
+		// JSON numbers are decoded as float64.
+		if numVal, isNumber := val.(float64); isNumber {
+			return int(numVal)
+		}
+	}
+	return defaultValue
+}